@@ -0,0 +1,433 @@
+package cli
+
+import (
+	"flag"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mjwhitta/errors"
+)
+
+// Bind will create a cli flag for each tagged field of the provided
+// struct pointer, as if Flag() had been called for each. Fields are
+// configured with the following tags:
+//
+//	choices:"a,b"       Limit the flag to the provided values
+//	cli:"s,long"        The short and/or long flag names and aliases
+//	default:"value"     The default value (or the field value)
+//	                    (comma-separated for lists, as in "a,b")
+//	deprecated:"text"   Mark the flag as deprecated with a message
+//	desc:"text"         The description
+//	env:"NAME"          The environment variable to fallback to
+//	hidden:"true"       Hide the flag from Usage() and --readme
+//	max:"n"             Limit the Counter to the provided maximum
+//	nodefault:"true"    Hide the default in Usage() and --readme
+//	norepeat:"true"     Fail Parse() if the flag is provided twice
+//	occurs:"min,max"    Limit occurrences of a list or Counter
+//	optional:"value"    The value used if the flag is provided bare
+//	placeholder:"FILE"  The name of the flag's value in Usage()
+//	replacedby:"name"   Mark the flag as deprecated by another flag
+//	required:"true"     Fail Parse() if the flag is not provided
+//	secret:"true"       Hide the flag and never suggest it
+//	sorted:"true"       Keep a set flag sorted
+//
+// Nested structs are bound as well, unless they are unexported. If a
+// nested struct has a cli tag, it is used as a prefix for the long
+// flag names of its fields. Below is an example:
+//
+//	var flags struct {
+//		Verbose bool `cli:"v,verbose" desc:"Show more output."`
+//		DB      struct {
+//			Host string `cli:"host" default:"db" desc:"Host."`
+//			Port int    `cli:"port" default:"5432" desc:"Port."`
+//		} `cli:"db"`
+//	}
+//
+//	if e := cli.Bind(&flags); e != nil {
+//		panic(e)
+//	}
+//
+// This would create the -v, --verbose, --db-host, and --db-port
+// flags. No flags are created if any field is invalid. Any returned
+// error will be a DefinitionError.
+func Bind(v any) error {
+	var e error
+	var fs []*cliFlag
+	var rv reflect.Value = reflect.ValueOf(v)
+
+	if (rv.Kind() != reflect.Pointer) || rv.IsNil() {
+		return &DefinitionError{
+			Err: errors.New("bind requires a pointer to a struct"),
+		}
+	}
+
+	if rv.Elem().Kind() != reflect.Struct {
+		return &DefinitionError{
+			Err: errors.New("bind requires a pointer to a struct"),
+		}
+	}
+
+	if fs, e = bindStruct(rv.Elem(), "", "", nil); e != nil {
+		return e
+	}
+
+	// Only register flags once every field is known to be valid
+	for _, f := range fs {
+		if e = addFlag(f); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// bindField will return the flag for the provided tagged struct
+// field.
+func bindField(
+	fv reflect.Value, field reflect.StructField, pre string,
+) (*cliFlag, error) {
+	var e error
+	var f *cliFlag
+	var ok bool
+	var tag string
+	var u uint64
+
+	if !field.IsExported() {
+		return nil, errors.New("not exported")
+	}
+
+	if f, e = newFlag(fv.Addr().Interface()); e != nil {
+		return nil, e
+	}
+
+	tag = field.Tag.Get("cli")
+
+	for _, name := range strings.Split(tag, ",") {
+		name = strings.TrimSpace(name)
+
+		switch {
+		case name == "":
+		case len(name) == 1:
+			if f.short == "" {
+				f.short = name
+			} else {
+				f.aliases = append(f.aliases, name)
+			}
+		case f.long == "":
+			f.long = pre + name
+		default:
+			f.aliases = append(f.aliases, pre+name)
+		}
+	}
+
+	f.desc = strings.TrimSpace(field.Tag.Get("desc"))
+	f.env = field.Tag.Get("env")
+	f.placeholder = field.Tag.Get("placeholder")
+
+	if tag, ok = field.Tag.Lookup("choices"); ok {
+		for _, choice := range strings.Split(tag, ",") {
+			f.choices = append(f.choices, strings.TrimSpace(choice))
+		}
+	}
+
+	if tag, ok = field.Tag.Lookup("optional"); ok {
+		OptionalValue(tag)(f)
+	}
+
+	if tag, ok = field.Tag.Lookup("deprecated"); ok {
+		Deprecated(tag)(f)
+	}
+
+	if tag, ok = field.Tag.Lookup("replacedby"); ok {
+		ReplacedBy(tag)(f)
+	}
+
+	if f.hidden, e = boolTag(field, "hidden"); e != nil {
+		return nil, e
+	}
+
+	if f.required, e = boolTag(field, "required"); e != nil {
+		return nil, e
+	}
+
+	if f.secret, e = boolTag(field, "secret"); e != nil {
+		return nil, e
+	}
+
+	if f.noDefault, e = boolTag(field, "nodefault"); e != nil {
+		return nil, e
+	}
+
+	if f.sorted, e = boolTag(field, "sorted"); e != nil {
+		return nil, e
+	}
+
+	if f.noRepeat, e = boolTag(field, "norepeat"); e != nil {
+		return nil, e
+	}
+
+	f.hidden = f.hidden || f.secret
+
+	if tag, ok = field.Tag.Lookup("default"); ok {
+		if f.val, e = parseDefault(f.ptr, tag); e != nil {
+			return nil, e
+		}
+	} else if !f.isList || f.isSlice() {
+		f.val = fv.Interface()
+	}
+
+	if tag, ok = field.Tag.Lookup("max"); ok {
+		u, e = strconv.ParseUint(tag, 0, strconv.IntSize)
+		if e != nil {
+			return nil, errors.Newf("invalid max tag %q", tag)
+		}
+
+		f.maxCount = Counter(u)
+	}
+
+	if tag, ok = field.Tag.Lookup("occurs"); ok {
+		if f.minOccurs, f.maxOccurs, e = parseOccurs(tag); e != nil {
+			return nil, e
+		}
+	}
+
+	return f, nil
+}
+
+// bindStruct will append the flags for the tagged fields of the
+// provided struct, and of any nested structs, to the provided flags.
+// Each flag is validated, but not registered.
+func bindStruct(
+	rv reflect.Value, path string, pre string, fs []*cliFlag,
+) ([]*cliFlag, error) {
+	var e error
+	var f *cliFlag
+	var field reflect.StructField
+	var ok bool
+	var tag string
+
+	for i := range rv.NumField() {
+		field = rv.Type().Field(i)
+		tag, ok = field.Tag.Lookup("cli")
+
+		if field.Type.Kind() != reflect.Struct {
+			if !ok {
+				continue
+			}
+
+			if f, e = bindField(rv.Field(i), field, pre); e == nil {
+				e = checkBound(f, fs)
+			}
+
+			if e != nil {
+				return nil, fieldError(path+field.Name, e)
+			}
+
+			fs = append(fs, f)
+
+			continue
+		}
+
+		// Fields of embedded structs are promoted, so are still
+		// reachable, but other unexported structs are not
+		if !field.IsExported() && !field.Anonymous {
+			if ok {
+				return nil, fieldError(
+					path+field.Name,
+					errors.New("not exported"),
+				)
+			}
+
+			continue
+		}
+
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tag += "-"
+		}
+
+		fs, e = bindStruct(
+			rv.Field(i),
+			path+field.Name+".",
+			pre+tag,
+			fs,
+		)
+		if e != nil {
+			return nil, e
+		}
+	}
+
+	return fs, nil
+}
+
+func boolTag(field reflect.StructField, key string) (bool, error) {
+	var b bool
+	var e error
+	var ok bool
+	var tag string
+
+	if tag, ok = field.Tag.Lookup(key); !ok {
+		return false, nil
+	} else if tag == "" {
+		return true, nil
+	}
+
+	if b, e = strconv.ParseBool(tag); e != nil {
+		return false, errors.Newf("invalid %s tag %q", key, tag)
+	}
+
+	return b, nil
+}
+
+// checkBound will validate the provided flag, and ensure that it
+// does not reuse the names of any of the other provided flags, which
+// are not yet registered.
+func checkBound(f *cliFlag, fs []*cliFlag) error {
+	var e error
+
+	if e = f.validate(); e != nil {
+		return &DefinitionError{Err: e, Name: f.name()}
+	}
+
+	for _, other := range fs {
+		for _, name := range f.names() {
+			if slices.Contains(other.names(), name) {
+				return &DefinitionError{
+					Err: errors.Newf(
+						"flag %s redefined",
+						dashed(name),
+					),
+					Name: f.name(),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func fieldError(field string, e error) error {
+	var de *DefinitionError
+	var ok bool
+
+	if de, ok = e.(*DefinitionError); !ok {
+		de = &DefinitionError{Err: e}
+	}
+
+	de.Field = field
+
+	return de
+}
+
+func parseOccurs(tag string) (uint, uint, error) {
+	var e error
+	var limits [2]uint64
+	var vals []string = strings.Split(tag, ",")
+
+	if len(vals) != len(limits) {
+		return 0, 0, errors.Newf("invalid occurs tag %q", tag)
+	}
+
+	for i, val := range vals {
+		if val = strings.TrimSpace(val); val == "" {
+			continue
+		}
+
+		limits[i], e = strconv.ParseUint(val, 0, strconv.IntSize)
+		if e != nil {
+			return 0, 0, errors.Newf("invalid occurs tag %q", tag)
+		}
+	}
+
+	return uint(limits[0]), uint(limits[1]), nil
+}
+
+func parseDefault(ptr any, val string) (any, error) {
+	var b bool
+	var e error
+	var f float64
+	var i int64
+	var u uint64
+
+	switch ptr.(type) {
+	case *bool:
+		if b, e = strconv.ParseBool(val); e == nil {
+			return b, nil
+		}
+	case *float64:
+		if f, e = strconv.ParseFloat(val, 64); e == nil {
+			return f, nil
+		}
+	case *int:
+		i, e = strconv.ParseInt(val, 0, strconv.IntSize)
+		if e == nil {
+			return int(i), nil
+		}
+	case *int64:
+		if i, e = strconv.ParseInt(val, 0, 64); e == nil {
+			return i, nil
+		}
+	case *string:
+		return val, nil
+	case *uint:
+		u, e = strconv.ParseUint(val, 0, strconv.IntSize)
+		if e == nil {
+			return uint(u), nil
+		}
+	case *uint64:
+		if u, e = strconv.ParseUint(val, 0, 64); e == nil {
+			return u, nil
+		}
+	case *float32, *int8, *int16, *int32, *uint8, *uint16, *uint32:
+		// Validated when the flag is enabled
+		return val, nil
+	case *Counter:
+		u, e = strconv.ParseUint(val, 0, strconv.IntSize)
+		if e == nil {
+			return uint(u), nil
+		}
+	case *FloatList, *Float32List, *IntList, *Int8List, *Int16List:
+		return parseListDefault(ptr, val)
+	case *Int32List, *StringList, *UintList, *Uint8List, *Uint16List:
+		return parseListDefault(ptr, val)
+	case *Uint32List:
+		return parseListDefault(ptr, val)
+	case *FloatSet, *Float32Set, *IntSet, *Int8Set, *Int16Set:
+		return parseListDefault(ptr, val)
+	case *Int32Set, *StringSet, *UintSet, *Uint8Set, *Uint16Set:
+		return parseListDefault(ptr, val)
+	case *Uint32Set:
+		return parseListDefault(ptr, val)
+	default:
+		return nil, errors.New("default value not supported")
+	}
+
+	return nil, errors.Newf("invalid default %q: %w", val, e)
+}
+
+func parseListDefault(ptr any, val string) (any, error) {
+	var e error
+	var fv flag.Value
+	var list reflect.Value = reflect.New(reflect.TypeOf(ptr).Elem())
+	var ok bool
+
+	if fv, ok = list.Interface().(flag.Value); !ok {
+		return nil, &DefinitionError{
+			Err: errors.Newf("%T is not a flag.Value", ptr),
+		}
+	}
+
+	for _, item := range strings.Split(val, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		if e = fv.Set(item); e != nil {
+			return nil, errors.Newf("invalid default %q: %w", val, e)
+		}
+	}
+
+	return list.Elem().Interface(), nil
+}
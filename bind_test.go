@@ -0,0 +1,95 @@
+package cli_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mjwhitta/cli"
+)
+
+func TestBindErrors(t *testing.T) {
+	var tests = []struct {
+		name  string
+		v     any
+		field string
+		flags []string
+	}{
+		{
+			name: "unexported nested struct",
+			v: &struct {
+				_ struct {
+					Host string `cli:"bind-host" desc:"Host."`
+				} `cli:"db"`
+			}{},
+			field: "_",
+		},
+		{
+			name: "invalid field after valid fields",
+			v: &struct {
+				A bool `cli:"bind-a" desc:"A."`
+				B bool `cli:"bind-b"`
+			}{},
+			field: "B",
+			flags: []string{"bind-a"},
+		},
+		{
+			name: "duplicate nested field",
+			v: &struct {
+				A   bool `cli:"bind-c-dd" desc:"A."`
+				Sub struct {
+					D bool `cli:"dd" desc:"D."`
+				} `cli:"bind-c"`
+			}{},
+			field: "Sub.D",
+			flags: []string{"bind-c-dd"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var de *cli.DefinitionError
+			var e error = cli.Bind(test.v)
+
+			if !errors.As(e, &de) {
+				t.Fatalf("got error %v, want DefinitionError", e)
+			}
+
+			if de.Field != test.field {
+				t.Errorf(
+					"got field %q, want %q",
+					de.Field,
+					test.field,
+				)
+			}
+
+			// Nothing should be registered if any field is invalid
+			for _, name := range test.flags {
+				if _, ok := cli.Lookup(name); ok {
+					t.Errorf("got flag %s, want none", name)
+				}
+			}
+		})
+	}
+}
+
+func TestBindUnexportedStruct(t *testing.T) {
+	var e error
+	var v struct {
+		Port int `cli:"bind-port" desc:"Port."`
+		_    struct {
+			Host string `cli:"bind-host" desc:"Host."`
+		}
+	}
+
+	if e = cli.Bind(&v); e != nil {
+		t.Fatal(e)
+	}
+
+	if _, ok := cli.Lookup("bind-port"); !ok {
+		t.Error("got no flag bind-port, want one")
+	}
+
+	if _, ok := cli.Lookup("bind-host"); ok {
+		t.Error("got flag bind-host, want none")
+	}
+}
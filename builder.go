@@ -0,0 +1,315 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Builder allows for declaring a flag with a typed API, as an
+// alternative to Flag(). The flag is not created until Ptr() or Var()
+// is called. Below is an example:
+//
+//	var name *string = cli.String("name").
+//		Short('n').
+//		Default("x").
+//		Desc("A short/long string flag.").
+//		Ptr()
+type Builder[T any] struct {
+	defined bool
+	e       error
+	f       *cliFlag
+	ptr     *T
+}
+
+type listType interface {
+	Float32List | FloatList |
+		Int8List | Int16List | Int32List | IntList |
+		StringList |
+		Uint8List | Uint16List | Uint32List | UintList |
+		Float32Set | FloatSet |
+		Int8Set | Int16Set | Int32Set | IntSet |
+		StringSet |
+		Uint8Set | Uint16Set | Uint32Set | UintSet
+}
+
+// Bool will start a new bool flag with the specified name.
+func Bool(name string) *Builder[bool] {
+	return newBuilder[bool](name)
+}
+
+// Count will start a new Counter flag with the specified name.
+func Count(name string) *Builder[Counter] {
+	return newBuilder[Counter](name)
+}
+
+// Float32 will start a new float32 flag with the specified name.
+func Float32(name string) *Builder[float32] {
+	return newBuilder[float32](name)
+}
+
+// Float64 will start a new float64 flag with the specified name.
+func Float64(name string) *Builder[float64] {
+	return newBuilder[float64](name)
+}
+
+// Int will start a new int flag with the specified name.
+func Int(name string) *Builder[int] {
+	return newBuilder[int](name)
+}
+
+// Int8 will start a new int8 flag with the specified name.
+func Int8(name string) *Builder[int8] {
+	return newBuilder[int8](name)
+}
+
+// Int16 will start a new int16 flag with the specified name.
+func Int16(name string) *Builder[int16] {
+	return newBuilder[int16](name)
+}
+
+// Int32 will start a new int32 flag with the specified name.
+func Int32(name string) *Builder[int32] {
+	return newBuilder[int32](name)
+}
+
+// Int64 will start a new int64 flag with the specified name.
+func Int64(name string) *Builder[int64] {
+	return newBuilder[int64](name)
+}
+
+// List will start a new list or set flag with the specified name.
+func List[T listType](name string) *Builder[T] {
+	return newBuilder[T](name)
+}
+
+// String will start a new string flag with the specified name.
+func String(name string) *Builder[string] {
+	return newBuilder[string](name)
+}
+
+// Uint will start a new uint flag with the specified name.
+func Uint(name string) *Builder[uint] {
+	return newBuilder[uint](name)
+}
+
+// Uint8 will start a new uint8 flag with the specified name.
+func Uint8(name string) *Builder[uint8] {
+	return newBuilder[uint8](name)
+}
+
+// Uint16 will start a new uint16 flag with the specified name.
+func Uint16(name string) *Builder[uint16] {
+	return newBuilder[uint16](name)
+}
+
+// Uint32 will start a new uint32 flag with the specified name.
+func Uint32(name string) *Builder[uint32] {
+	return newBuilder[uint32](name)
+}
+
+// Uint64 will start a new uint64 flag with the specified name.
+func Uint64(name string) *Builder[uint64] {
+	return newBuilder[uint64](name)
+}
+
+func newBuilder[T any](name string) *Builder[T] {
+	var b *Builder[T] = &Builder[T]{ptr: new(T)}
+	var zero T
+
+	if b.f, b.e = newFlag(b.ptr); b.e != nil {
+		return b
+	}
+
+	if !b.f.isList {
+		b.f.val = zero
+	}
+
+	if len(name) == 1 {
+		b.f.short = name
+	} else {
+		b.f.long = name
+	}
+
+	return b
+}
+
+// Alias will add additional names for the flag.
+func (b *Builder[T]) Alias(names ...string) *Builder[T] {
+	return b.With(Aliases(names...))
+}
+
+// Choices will limit the flag to the provided values.
+func (b *Builder[T]) Choices(vals ...string) *Builder[T] {
+	return b.With(Choices(vals...))
+}
+
+// Complete will use the provided Completer to complete the flag's
+// value during dynamic completion.
+func (b *Builder[T]) Complete(fn Completer) *Builder[T] {
+	return b.With(Complete(fn))
+}
+
+// Default will set the default value of the flag. The default value
+// of a list flag is replaced the first time the flag is provided.
+func (b *Builder[T]) Default(val T) *Builder[T] {
+	if b.f != nil {
+		b.f.gotVal = true
+		b.f.val = val
+	}
+
+	return b
+}
+
+func (b *Builder[T]) define() {
+	var exit int = 128
+
+	if b.defined {
+		return
+	}
+
+	b.defined = true
+
+	if b.e != nil {
+		b.e = &DefinitionError{Err: b.e}
+	} else {
+		b.e = addFlag(b.f)
+	}
+
+	if b.e != nil {
+		fmt.Fprintln(os.Stderr, b.e.Error())
+		os.Exit(exit)
+	}
+}
+
+// Deprecated will mark the flag as deprecated with the provided
+// message.
+func (b *Builder[T]) Deprecated(msg string) *Builder[T] {
+	return b.With(Deprecated(msg))
+}
+
+// Desc will set the description of the flag.
+func (b *Builder[T]) Desc(text ...string) *Builder[T] {
+	if b.f != nil {
+		b.f.desc = strings.TrimSpace(strings.Join(text, " "))
+	}
+
+	return b
+}
+
+// Env will set the environment variable to use, if the flag was not
+// provided on the command line.
+func (b *Builder[T]) Env(name string) *Builder[T] {
+	return b.With(Env(name))
+}
+
+// Hidden will hide the flag from Usage() and --readme.
+func (b *Builder[T]) Hidden() *Builder[T] {
+	if b.f != nil {
+		b.f.hidden = true
+	}
+
+	return b
+}
+
+// Long will set the long name of the flag.
+func (b *Builder[T]) Long(name string) *Builder[T] {
+	if b.f != nil {
+		b.f.long = name
+	}
+
+	return b
+}
+
+// NoDefault will prevent the default value of the flag from being
+// shown in Usage() and --readme.
+func (b *Builder[T]) NoDefault() *Builder[T] {
+	return b.With(NoDefault())
+}
+
+// NoRepeat will cause Parse() to fail if the flag is provided more
+// than once.
+func (b *Builder[T]) NoRepeat() *Builder[T] {
+	return b.With(NoRepeat())
+}
+
+// Occurrences will limit how many times a list or Counter flag can
+// be provided, at least lo and at most hi times. A hi of 0 means
+// there is no upper limit.
+func (b *Builder[T]) Occurrences(lo uint, hi uint) *Builder[T] {
+	return b.With(Occurrences(lo, hi))
+}
+
+// OptionalValue will allow the flag to be provided without a value.
+// The provided value is used when the flag is provided without one.
+func (b *Builder[T]) OptionalValue(val string) *Builder[T] {
+	return b.With(OptionalValue(val))
+}
+
+// Placeholder will set the name used for the flag's value in Usage()
+// and --readme.
+func (b *Builder[T]) Placeholder(name string) *Builder[T] {
+	return b.With(Placeholder(name))
+}
+
+// Ptr will create the flag and return a pointer to its value.
+func (b *Builder[T]) Ptr() *T {
+	b.define()
+	return b.ptr
+}
+
+// ReplacedBy will mark the flag as deprecated in favor of the named
+// flag.
+func (b *Builder[T]) ReplacedBy(name string) *Builder[T] {
+	return b.With(ReplacedBy(name))
+}
+
+// Required will cause Parse() to fail if the flag was not provided.
+func (b *Builder[T]) Required() *Builder[T] {
+	return b.With(Required())
+}
+
+// Secret will hide the flag from Usage() and --readme, and will also
+// prevent it from being suggested when an unknown flag is provided.
+func (b *Builder[T]) Secret() *Builder[T] {
+	return b.With(Secret())
+}
+
+// Short will set the short name of the flag.
+func (b *Builder[T]) Short(name rune) *Builder[T] {
+	if b.f != nil {
+		b.f.short = string(name)
+	}
+
+	return b
+}
+
+// Sorted will keep a set flag sorted in increasing order.
+func (b *Builder[T]) Sorted() *Builder[T] {
+	return b.With(Sorted())
+}
+
+// Var will create the flag, storing its value in the provided
+// pointer.
+func (b *Builder[T]) Var(ptr *T) {
+	if !b.defined && (ptr != nil) {
+		b.ptr = ptr
+
+		if b.f != nil {
+			b.f.ptr = ptr
+		}
+	}
+
+	b.define()
+}
+
+// With will apply the provided options to the flag.
+func (b *Builder[T]) With(opts ...FlagOption) *Builder[T] {
+	if b.f != nil {
+		for _, opt := range opts {
+			opt(b.f)
+		}
+	}
+
+	return b
+}
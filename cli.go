@@ -4,7 +4,6 @@ import (
 	"flag"
 	"fmt"
 	"os"
-	"sort"
 	"strings"
 )
 
@@ -34,6 +33,17 @@ func ExitStatus(text ...string) {
 //		"A flag can be hidden by passing true as the last arg.",
 //		true,
 //	)
+//
+//	var token string
+//	cli.Flag(
+//		&token,
+//		"t",
+//		"token",
+//		"",
+//		"Options can be passed along with the other args.",
+//		cli.Env("TOKEN"),
+//		cli.Required(),
+//	)
 func Flag(args ...any) {
 	var e error
 	var exit int = 128
@@ -44,159 +54,44 @@ func Flag(args ...any) {
 	}
 
 	if f, e = newFlag(args...); e != nil {
-		fmt.Fprintln(os.Stderr, e.Error())
-		os.Exit(exit)
-	}
-
-	if e = f.validate(); e != nil {
-		fmt.Fprintln(os.Stderr, e.Error())
-		os.Exit(exit)
-	}
-
-	flags = append(flags, f)
-
-	if e = f.enable(f.short); e != nil {
-		fmt.Fprintln(os.Stderr, e.Error())
-		os.Exit(exit)
+		e = &DefinitionError{Err: e}
+	} else {
+		e = addFlag(f)
 	}
 
-	if e = f.enable(f.long); e != nil {
+	if e != nil {
 		fmt.Fprintln(os.Stderr, e.Error())
 		os.Exit(exit)
 	}
-
-	f.updateMaxWidth()
-}
-
-func getAuthors(md bool) string {
-	var sb strings.Builder
-
-	if len(Authors) > 0 {
-		if md {
-			sb.WriteString("\n## Authors\n\n")
-
-			for _, author := range Authors {
-				sb.WriteString(author + "\n")
-			}
-		} else {
-			sb.WriteString("AUTHORS\n")
-
-			for _, author := range Authors {
-				for range TabWidth {
-					sb.WriteString(" ")
-				}
-
-				sb.WriteString(author + "\n")
-			}
-		}
-	}
-
-	return sb.String()
 }
 
-func getBugEmail(md bool) string {
-	var lines []string
-	var sb strings.Builder
-
-	if BugEmail != "" {
-		if md {
-			lines = wrap(
-				"Email bug reports to <"+BugEmail+">.",
-				MaxWidth,
-			)
-
-			sb.WriteString("\n## Reporting bugs\n\n")
-
-			for _, line := range lines {
-				sb.WriteString(line + "\n")
-			}
-		} else {
-			lines = wrap(
-				"Email bug reports to <"+BugEmail+">.",
-				MaxWidth-TabWidth,
-			)
-
-			sb.WriteString("\nBUG REPORTS\n")
-
-			for _, line := range lines {
-				for range TabWidth {
-					sb.WriteString(" ")
-				}
-
-				sb.WriteString(line + "\n")
-			}
-		}
-	}
-
-	return sb.String()
-}
-
-func getCustomSections(md bool) string {
-	var sb strings.Builder
+func addFlag(f *cliFlag) error {
+	var e error
 
-	for _, s := range sections {
-		s.md = md
-		sb.WriteString(s.String())
+	if e = f.validate(); e != nil {
+		return &DefinitionError{Err: e, Name: f.name()}
 	}
 
-	return sb.String()
-}
-
-func getExitStatus(md bool) string {
-	var sb strings.Builder
-
-	if exitStatus != "" {
-		if md {
-			sb.WriteString("\n## Exit status\n\n")
-
-			for _, line := range wrap(exitStatus, MaxWidth) {
-				sb.WriteString(line + "\n")
-			}
-		} else {
-			sb.WriteString("\nEXIT STATUS\n")
+	f.setPlaceholder()
 
-			for _, line := range wrap(exitStatus, MaxWidth-TabWidth) {
-				for range TabWidth {
-					sb.WriteString(" ")
-				}
+	flags = append(flags, f)
 
-				sb.WriteString(line + "\n")
-			}
+	for _, name := range f.names() {
+		if e = f.enable(name); e != nil {
+			return &DefinitionError{Err: e, Name: f.name()}
 		}
 	}
 
-	return sb.String()
-}
-
-func getSeeAlso(md bool) string {
-	var sb strings.Builder
-	var tmp string
-
-	if len(SeeAlso) == 0 {
-		return ""
+	if fl := flag.Lookup(f.names()[0]); fl != nil {
+		f.defVal = fl.DefValue
 	}
 
-	tmp = strings.Join(SeeAlso, ", ")
-
-	if md {
-		sb.WriteString("\n## See also\n\n")
-
-		for _, ln := range wrap(tmp, MaxWidth) {
-			sb.WriteString(ln + "\n")
-		}
-	} else {
-		sb.WriteString("\nSEE ALSO\n")
-
-		for _, ln := range wrap(tmp, MaxWidth) {
-			for range TabWidth {
-				sb.WriteString(" ")
-			}
-
-			sb.WriteString(ln + "\n")
-		}
+	// Hidden flags are never shown, so should not affect alignment
+	if !f.hidden {
+		f.updateMaxWidth()
 	}
 
-	return sb.String()
+	return nil
 }
 
 // Info sets the description of how the program works.
@@ -210,108 +105,86 @@ func init() {
 	flag.Usage = func() { Usage(exit) }
 
 	Flag(&help, "h", "help", false, "Display this help message.")
-	Flag(&readme, "readme", false, "Autogenerate README.md.", true)
+	Flag(
+		&readme,
+		"readme",
+		"",
+		"Autogenerate README.md.",
+		true,
+		OptionalValue("-"),
+		Placeholder("PATH"),
+	)
+	Flag(
+		&readmeCheck,
+		"readme-check",
+		"",
+		"Check if README.md is up to date.",
+		true,
+		Placeholder("PATH"),
+	)
+	Flag(&man, "man", false, "Autogenerate manpage.", true)
+	Flag(
+		&helpJSON,
+		"help-json",
+		false,
+		"Describe the cli as JSON.",
+		true,
+	)
+	Flag(
+		&completion,
+		"completion",
+		"",
+		"Generate a shell completion script.",
+		true,
+		Choices("bash", "fish", "zsh"),
+		Placeholder("SHELL"),
+	)
+	Flag(
+		&compShim,
+		"completion-shim",
+		"",
+		"Generate a dynamic shell completion script.",
+		true,
+		Choices("bash", "fish", "zsh"),
+		Placeholder("SHELL"),
+	)
 }
 
 // PrintDefaults will print the configured flags for Usage(). It
-// ignores --readme and other hidden flags.
+// ignores --readme and other hidden flags. Deprecated flags are
+// listed in a separate section, if ShowDeprecated is true. The output
+// is rendered from the options block of the help template.
 func PrintDefaults() {
-	if !sort.SliceIsSorted(flags, less) {
-		sort.SliceStable(flags, less)
-	}
-
-	for _, f := range flags {
-		if !f.hidden {
-			fmt.Fprint(os.Stderr, f.String())
-		}
-	}
-
-	if Align {
-		fmt.Fprintln(os.Stderr)
-	}
+	printHelp("options")
 }
 
-// PrintExtra will print the Usage() extra details.
+// PrintExtra will print the Usage() extra details. The output is
+// rendered from the extra block of the help template.
 func PrintExtra() {
-	var extra string
-
-	extra += getCustomSections(false)
-	extra += getAuthors(false)
-	extra += getBugEmail(false)
-	extra += getExitStatus(false)
-	extra += getSeeAlso(false)
-
-	fmt.Fprint(os.Stderr, extra)
+	printHelp("extra")
 }
 
-// PrintHeader will print the Usage() header.
+// PrintHeader will print the Usage() header. The output is rendered
+// from the header block of the help template.
 func PrintHeader() {
-	var sb strings.Builder
-
-	for _, line := range wrap("Usage: "+Banner, MaxWidth) {
-		sb.WriteString(line + "\n")
-	}
-
-	sb.WriteString("\nDESCRIPTION\n")
-
-	for _, line := range wrap(info, MaxWidth-TabWidth) {
-		for range TabWidth {
-			sb.WriteString(" ")
-		}
-
-		sb.WriteString(line + "\n")
-	}
-
-	sb.WriteString("\nOPTIONS\n")
-
-	fmt.Fprint(os.Stderr, sb.String())
+	printHelp("header")
 }
 
 // Readme will attempt to print out a basic README.md file based on
-// the provided details.
+// the provided details. The output is rendered from ReadmeTemplate,
+// if set, or DefaultReadmeTemplate. Use WriteReadme() to write the
+// README.md to a file instead.
 func Readme() {
-	var sb strings.Builder
-
-	// Title
-	sb.WriteString("# " + Title + "\n")
-
-	// Synopsis
-	sb.WriteString("\n## Synopsis\n\n")
-
-	for _, line := range wrap(Banner, MaxWidth) {
-		sb.WriteString("`" + line + "`\n")
-	}
-
-	// Description
-	sb.WriteString("\n## Description\n\n")
-
-	for _, line := range wrap(info, MaxWidth) {
-		sb.WriteString(line + "\n")
-	}
-
-	// Options and descriptions
-	sb.WriteString("\n## Options\n\n")
-
-	if !sort.SliceIsSorted(flags, less) {
-		sort.SliceStable(flags, less)
-	}
-
-	sb.WriteString("Option | Args | Description\n")
-	sb.WriteString("------ | ---- | -----------\n")
+	var e error
+	var exit int = 128
+	var out string
 
-	for _, f := range flags {
-		if !f.hidden {
-			sb.WriteString(f.table())
-		}
+	if out, e = readmeText(); e != nil {
+		fmt.Fprintln(os.Stderr, e.Error())
+		os.Exit(exit)
 	}
 
-	sb.WriteString(getCustomSections(true))
-	sb.WriteString(getAuthors(true))
-	sb.WriteString(getBugEmail(true))
-	sb.WriteString(getExitStatus(true))
-	sb.WriteString(getSeeAlso(true))
-
-	fmt.Print(sb.String())
+	fmt.Print(out)
 	os.Exit(0)
 }
 
@@ -340,14 +213,34 @@ func SectionAligned(title string, align string, text ...string) {
 	)
 }
 
-// Usage will essentially print a manpage.
+// Usage will essentially print a manpage. The output is rendered
+// from HelpTemplate, if set, or DefaultHelpTemplate.
 func Usage(status int) {
-	PrintHeader()
-	PrintDefaults()
-	PrintExtra()
+	printHelp("help")
 	os.Exit(status)
 }
 
+// printHelp will print the named block of the help template.
+func printHelp(block string) {
+	var e error
+	var out string
+
+	out, e = render(
+		"help", block, DefaultHelpTemplate, HelpTemplate, false,
+	)
+	if e != nil {
+		fmt.Fprintln(os.Stderr, e.Error())
+		return
+	}
+
+	fmt.Fprint(os.Stderr, out)
+}
+
+func tableHeader() string {
+	return "Option | Args | Default | Description\n" +
+		"------ | ---- | ------- | -----------\n"
+}
+
 func wrap(input string, width int) []string {
 	var line string
 	var lines []string
@@ -2,76 +2,189 @@ package cli
 
 import (
 	"flag"
+	"fmt"
 	"math"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/mjwhitta/errors"
 )
 
 type cliFlag struct {
-	desc    string
-	gotVal  bool
-	hidden  bool
-	isList  bool
-	long    string
-	short   string
-	thetype string
-	ptr     any
-	val     any
+	aliases     []string
+	choices     []string
+	completer   Completer
+	count       uint
+	decrement   bool
+	defVal      string
+	depMsg      string
+	deprecated  bool
+	desc        string
+	env         string
+	gotVal      bool
+	hidden      bool
+	isList      bool
+	long        string
+	maxCount    Counter
+	maxOccurs   uint
+	minOccurs   uint
+	noDefault   bool
+	noRepeat    bool
+	nullable    bool
+	optional    bool
+	optVal      string
+	placeholder string
+	replacement string
+	required    bool
+	secret      bool
+	short       string
+	sorted      bool
+	source      FlagSource
+	thetype     string
+	ptr         any
+	val         any
+	warned      bool
+}
+
+// sorter is implemented by the generated set types.
+type sorter interface {
+	Sort()
 }
 
 func newFlag(args ...any) (*cliFlag, error) {
 	var f *cliFlag = &cliFlag{}
+	var opts []FlagOption
 
 	for _, arg := range args {
 		switch arg := arg.(type) {
 		case *bool, *float64, *int, *int64, *string, *uint, *uint64:
 			f.ptr = arg
+		case *float32, *int8, *int16, *int32:
+			f.ptr = arg
+		case *uint8, *uint16, *uint32:
+			f.ptr = arg
+		case **bool, **float32, **float64, **string:
+			f.nullable = true
+			f.ptr = arg
+		case **int, **int8, **int16, **int32, **int64:
+			f.nullable = true
+			f.ptr = arg
+		case **uint, **uint8, **uint16, **uint32, **uint64:
+			f.nullable = true
+			f.ptr = arg
 		case *Counter, *FloatList, *IntList, *StringList, *UintList:
 			f.isList = true
 			f.ptr = arg
+		case *Float32List, *Int8List, *Int16List, *Int32List:
+			f.isList = true
+			f.ptr = arg
+		case *Uint8List, *Uint16List, *Uint32List:
+			f.isList = true
+			f.ptr = arg
+		case *FloatSet, *Float32Set, *IntSet, *Int8Set, *Int16Set:
+			f.isList = true
+			f.ptr = arg
+		case *Int32Set, *StringSet, *UintSet, *Uint8Set, *Uint16Set:
+			f.isList = true
+			f.ptr = arg
+		case *Uint32Set:
+			f.isList = true
+			f.ptr = arg
 		case bool:
-			// First time thru, set val
-			if !f.gotVal {
+			// First time thru, set val, unless a list
+			if !f.gotVal && !f.isList {
 				f.gotVal = true
 				f.val = arg
 			} else { // Otherwise, set hidden
 				f.hidden = arg
 			}
-		case float64, int, int64, uint, uint64:
+		case float32, float64, int, int8, int16, int32, int64:
+			f.gotVal = true
+			f.val = arg
+		case uint, uint8, uint16, uint32, uint64:
 			f.gotVal = true
 			f.val = arg
 		case string:
 			f.processString(arg)
+		case FlagOption:
+			opts = append(opts, arg)
 		default:
-			return nil, errors.Newf("unsupported flag type")
+			// Lists can have a slice as their default value
+			switch {
+			case !f.isList:
+				return nil, errors.Newf("unsupported flag type")
+			case reflect.TypeOf(arg).Kind() != reflect.Slice:
+				return nil, errors.Newf("unsupported flag type")
+			}
+
+			f.gotVal = true
+			f.val = arg
 		}
 	}
 
+	for _, opt := range opts {
+		opt(f)
+	}
+
 	f.setType()
 
 	return f, nil
 }
 
-func (f *cliFlag) column(align bool) string {
+// arg will return the placeholder for the flag's value, prefixed
+// with the provided separator, as in: =INT or [=INT] if the value is
+// optional.
+func (f *cliFlag) arg(sep string) string {
+	switch {
+	case f.thetype == "":
+		return ""
+	case f.optional:
+		return "[=" + f.valueName() + "]"
+	default:
+		return sep + f.valueName()
+	}
+}
+
+// clearList will empty the flag's list, if it is a slice.
+func (f *cliFlag) clearList() {
+	var rv reflect.Value
+
+	if f.isSlice() {
+		rv = reflect.ValueOf(f.ptr).Elem()
+		rv.Set(reflect.Zero(rv.Type()))
+	}
+}
+
+// column will return the flag names and placeholder, styled with ANSI
+// escape codes if color is true.
+func (f *cliFlag) column(align bool, color bool) string {
 	var fillto int
+	var longs []string = f.longs()
 	var sb strings.Builder
 	var sep string
+	var shorts []string = f.shorts()
 
-	// Short flag
-	if f.short != "" {
-		sb.WriteString("-" + f.short)
-
-		if (f.thetype != "") && (f.long == "") {
-			sb.WriteString(" " + f.thetype)
+	// Short flags
+	for i, short := range shorts {
+		if i > 0 {
+			sb.WriteString(", ")
 		}
+
+		sb.WriteString(ansi(color, ansiCyan, "-"+short))
+	}
+
+	if (len(shorts) > 0) && (len(longs) == 0) {
+		sb.WriteString(ansi(color, ansiDim, f.arg(" ")))
 	}
 
 	// Separator
 	sep = "  "
 
-	if f.short != "" {
-		if f.long != "" {
+	if len(shorts) > 0 {
+		if len(longs) > 0 {
 			sep = ", "
 		}
 
@@ -80,24 +193,90 @@ func (f *cliFlag) column(align bool) string {
 
 	// Alignment
 	if align {
-		fillto = colWidth.short + len(sep) - sb.Len()
+		fillto = colWidth.short + len(sep) - visibleLen(sb.String())
 		for range fillto {
 			sb.WriteString(" ")
 		}
 	}
 
-	// Long flag
-	if f.long != "" {
-		sb.WriteString("--" + f.long)
-
-		if f.thetype != "" {
-			sb.WriteString("=" + f.thetype)
+	// Long flags
+	for i, long := range longs {
+		if i > 0 {
+			sb.WriteString(", ")
 		}
+
+		sb.WriteString(ansi(color, ansiCyan, "--"+long))
+	}
+
+	if len(longs) > 0 {
+		sb.WriteString(ansi(color, ansiDim, f.arg("=")))
 	}
 
 	return sb.String()
 }
 
+func (f *cliFlag) defaultValue() string {
+	if f.noDefault || f.zeroDefault() {
+		return ""
+	}
+
+	if _, ok := f.ptr.(*string); ok {
+		return strconv.Quote(f.defVal)
+	}
+
+	return f.defVal
+}
+
+func (f *cliFlag) deprecation() string {
+	var sb strings.Builder
+
+	sb.WriteString("deprecated")
+
+	if f.replacement != "" {
+		sb.WriteString(", use " + dashed(f.replacement) + " instead")
+	}
+
+	if f.depMsg != "" {
+		sb.WriteString(": " + f.depMsg)
+	}
+
+	return sb.String()
+}
+
+func (f *cliFlag) description(md bool) string {
+	var notes []string
+	var out string = f.desc
+
+	if f.deprecated {
+		out += " (" + f.deprecation() + ")"
+	}
+
+	if len(f.choices) > 0 {
+		notes = append(
+			notes,
+			"one of: "+strings.Join(f.choices, ", "),
+		)
+	}
+
+	if !md && (f.defaultValue() != "") {
+		notes = append(notes, "default: "+f.defaultValue())
+	}
+
+	if f.isList {
+		notes = append(notes, "repeatable")
+	}
+
+	if f.occurrences() != "" {
+		notes = append(notes, f.occurrences())
+	}
+
+	if len(notes) > 0 {
+		out += " (" + strings.Join(notes, "; ") + ")"
+	}
+
+	return strings.TrimSpace(out)
+}
+
 //nolint:cyclop,gocyclo,maintidx // I hate it too
 func (f *cliFlag) enable(s string) error {
 	var e error
@@ -106,6 +285,10 @@ func (f *cliFlag) enable(s string) error {
 		return nil
 	}
 
+	if e = f.setListDefault(); e != nil {
+		return e
+	}
+
 	switch ptr := f.ptr.(type) {
 	case *bool:
 		if val, ok := f.val.(bool); ok {
@@ -115,7 +298,25 @@ func (f *cliFlag) enable(s string) error {
 
 		return errors.Newf("invalid bool %v for %s", f.val, f.name())
 	case *Counter:
-		flag.Var(ptr, s, f.desc)
+		switch f.val.(type) {
+		case nil, bool:
+		default:
+			if e = ptr.Set(fmt.Sprint(f.val)); e != nil {
+				e = errors.Newf(
+					"invalid Counter %v for %s",
+					f.val,
+					f.name(),
+				)
+
+				return e
+			}
+		}
+
+		flag.Var(
+			&counterValue{c: ptr, dec: f.decrement, max: f.maxCount},
+			s,
+			f.desc,
+		)
 	case *float64:
 		if val, ok := f.val.(float64); ok {
 			flag.Float64Var(ptr, s, val, f.desc)
@@ -125,8 +326,8 @@ func (f *cliFlag) enable(s string) error {
 		e = errors.Newf("invalid float64 %v for %s", f.val, f.name())
 
 		return e
-	case *FloatList:
-		flag.Var(ptr, s, f.desc)
+	case *float32, *int8, *int16, *int32, *uint8, *uint16, *uint32:
+		return f.enableValue(newValue(ptr), s)
 	case *int:
 		switch val := f.val.(type) {
 		case int:
@@ -209,8 +410,6 @@ func (f *cliFlag) enable(s string) error {
 		}
 
 		return errors.Newf("invalid int64 %v for %s", f.val, f.name())
-	case *IntList:
-		flag.Var(ptr, s, f.desc)
 	case *string:
 		if val, ok := f.val.(string); ok {
 			flag.StringVar(ptr, s, val, f.desc)
@@ -220,8 +419,6 @@ func (f *cliFlag) enable(s string) error {
 		e = errors.Newf("invalid string %v for %s", f.val, f.name())
 
 		return e
-	case *StringList:
-		flag.Var(ptr, s, f.desc)
 	case *uint:
 		switch val := f.val.(type) {
 		case int:
@@ -314,13 +511,113 @@ func (f *cliFlag) enable(s string) error {
 		e = errors.Newf("invalid uint64 %v for %s", f.val, f.name())
 
 		return e
-	case *UintList:
+	case flag.Value:
+		// Lists and sets
 		flag.Var(ptr, s, f.desc)
+	default:
+		if !f.nullable {
+			return errors.Newf("unsupported flag type %T", f.ptr)
+		}
+
+		flag.Var(&nullValue{ptr: reflect.ValueOf(ptr)}, s, f.desc)
 	}
 
 	return nil
 }
 
+func (f *cliFlag) enableValue(v flag.Value, s string) error {
+	if f.val != nil {
+		if e := v.Set(fmt.Sprint(f.val)); e != nil {
+			return errors.Newf(
+				"invalid default %v for %s: %w",
+				f.val,
+				f.name(),
+				e,
+			)
+		}
+	}
+
+	flag.Var(v, s, f.desc)
+
+	return nil
+}
+
+// hint will return the type of completion for the flag's value.
+func (f *cliFlag) hint() valueHint {
+	var upper string = strings.ToUpper(f.valueName())
+
+	switch {
+	case f.thetype == "":
+		return hintNone
+	case len(f.choices) > 0:
+		return hintChoices
+	case strings.Contains(upper, "DIR"):
+		return hintDirs
+	case strings.Contains(upper, "FILE"):
+		return hintFiles
+	case strings.Contains(upper, "PATH"):
+		return hintFiles
+	default:
+		return hintAny
+	}
+}
+
+// isSlice will return whether or not the flag's value is a slice,
+// such as a StringList. Counters are lists, but not slices.
+func (f *cliFlag) isSlice() bool {
+	var rv reflect.Value = reflect.ValueOf(f.ptr)
+
+	return (rv.Kind() == reflect.Pointer) &&
+		(rv.Elem().Kind() == reflect.Slice)
+}
+
+func (f *cliFlag) longs() []string {
+	var longs []string
+
+	if f.long != "" {
+		longs = append(longs, f.long)
+	}
+
+	for _, alias := range f.aliases {
+		if len(alias) > 1 {
+			longs = append(longs, alias)
+		}
+	}
+
+	return longs
+}
+
+// man will return the flag as a man(7) roff .TP entry.
+func (f *cliFlag) man() string {
+	var names []string
+	var sb strings.Builder
+
+	for _, short := range f.shorts() {
+		names = append(names, "\\fB"+roff("-"+short)+"\\fR")
+	}
+
+	for _, long := range f.longs() {
+		names = append(names, "\\fB"+roff("--"+long)+"\\fR")
+	}
+
+	sb.WriteString(".TP\n")
+	sb.WriteString(strings.Join(names, ", "))
+
+	switch {
+	case f.thetype == "":
+	case f.optional:
+		sb.WriteString("[=\\fI" + roff(f.valueName()) + "\\fR]")
+	case len(f.longs()) == 0:
+		sb.WriteString(" \\fI" + roff(f.valueName()) + "\\fR")
+	default:
+		sb.WriteString("=\\fI" + roff(f.valueName()) + "\\fR")
+	}
+
+	sb.WriteString("\n" + roff(f.description(false)) + "\n")
+
+	return sb.String()
+}
+
 func (f *cliFlag) name() string {
 	if f.long != "" {
 		return "--" + f.long
@@ -329,6 +626,25 @@ func (f *cliFlag) name() string {
 	return "-" + f.short
 }
 
+func (f *cliFlag) names() []string {
+	return append(f.shorts(), f.longs()...)
+}
+
+// occurrences will return a description of the occurrence limits of
+// the flag, if any, as in: 1 to 3 times
+func (f *cliFlag) occurrences() string {
+	switch {
+	case (f.minOccurs > 0) && (f.maxOccurs > 0):
+		return fmt.Sprint(f.minOccurs) + " to " + times(f.maxOccurs)
+	case f.minOccurs > 0:
+		return "at least " + times(f.minOccurs)
+	case f.maxOccurs > 0:
+		return "at most " + times(f.maxOccurs)
+	}
+
+	return ""
+}
+
 func (f *cliFlag) processString(arg string) {
 	var validLong bool = !f.gotVal && !strings.Contains(arg, " ")
 
@@ -339,7 +655,7 @@ func (f *cliFlag) processString(arg string) {
 		f.short = strings.TrimSpace(arg)
 	case (f.long == "") && (len(arg) > 1) && validLong:
 		f.long = strings.TrimSpace(arg)
-	case !f.isList && (f.val == nil):
+	case !f.isList && !f.nullable && (f.val == nil):
 		f.gotVal = true
 		f.val = arg
 	default:
@@ -347,16 +663,180 @@ func (f *cliFlag) processString(arg string) {
 	}
 }
 
+// repeated will return whether or not the flag was already provided
+// on the command line, if it is not allowed to be repeated.
+func (f *cliFlag) repeated() bool {
+	if f.isList || (!Strict && !f.noRepeat) {
+		return false
+	}
+
+	return f.source == FromCommandLine
+}
+
+func (f *cliFlag) set(name string, val string, src FlagSource) error {
+	var c *Counter
+	var e error
+	var ok bool
+
+	if (len(f.choices) > 0) && !slices.Contains(f.choices, val) {
+		return errors.Newf(
+			"must be one of: %s",
+			strings.Join(f.choices, ", "),
+		)
+	}
+
+	// Values from a new source replace those from the previous one,
+	// but a Counter still counts up from its default
+	if src != f.source {
+		if c, ok = f.ptr.(*Counter); ok && (f.source != FromDefault) {
+			*c = 0
+		}
+
+		f.count = 0
+	}
+
+	// List defaults are replaced, not appended to, and an empty
+	// value clears the list
+
+	if f.isSlice() && ((f.count == 0) || (val == "")) {
+		f.clearList()
+	}
+
+	if !f.isSlice() || (val != "") {
+		if e = flag.Set(name, val); e != nil {
+			return e
+		}
+	}
+
+	f.sort()
+
+	f.count++
+	f.source = src
+
+	if f.deprecated && !f.warned {
+		f.warned = true
+		fmt.Fprintf(
+			os.Stderr,
+			"Warning: %s is %s\n",
+			dashed(name),
+			f.deprecation(),
+		)
+	}
+
+	return nil
+}
+
+// setListDefault will copy the default value into the flag's list, so
+// that it is shown in Usage() and --readme.
+func (f *cliFlag) setListDefault() error {
+	var def reflect.Value
+	var rv reflect.Value
+
+	if !f.isSlice() || (f.val == nil) {
+		return nil
+	}
+
+	def = reflect.ValueOf(f.val)
+	rv = reflect.ValueOf(f.ptr).Elem()
+
+	if (def.Kind() != reflect.Slice) || !def.CanConvert(rv.Type()) {
+		return errors.Newf(
+			"invalid %s %v for %s",
+			rv.Type().Name(),
+			f.val,
+			f.name(),
+		)
+	}
+
+	// Copy so the default is never modified
+	def = def.Convert(rv.Type())
+	rv.Set(reflect.AppendSlice(reflect.Zero(rv.Type()), def))
+
+	f.sort()
+
+	return nil
+}
+
+func (f *cliFlag) setPlaceholder() {
+	var after string
+	var before string
+	var found bool
+	var name string
+
+	if (f.thetype == "") || (f.placeholder != "") {
+		return
+	}
+
+	if !BacktickPlaceholders {
+		return
+	}
+
+	// Use the first backticked word, like flag.UnquoteUsage()
+	if before, after, found = strings.Cut(f.desc, "`"); !found {
+		return
+	}
+
+	if name, after, found = strings.Cut(after, "`"); !found {
+		return
+	}
+
+	f.desc = before + name + after
+	f.placeholder = name
+}
+
 func (f *cliFlag) setType() {
 	switch f.ptr.(type) {
-	case *float64, *FloatList:
+	case *float32, *float64, *Float32List, *FloatList:
+		f.thetype = "FLOAT"
+	case *Float32Set, *FloatSet:
 		f.thetype = "FLOAT"
-	case *int, *int64, *IntList:
+	case **float32, **float64:
+		f.thetype = "FLOAT"
+	case *int, *int8, *int16, *int32, *int64:
+		f.thetype = "INT"
+	case **int, **int8, **int16, **int32, **int64:
+		f.thetype = "INT"
+	case *Int8List, *Int16List, *Int32List, *IntList:
+		f.thetype = "INT"
+	case *Int8Set, *Int16Set, *Int32Set, *IntSet:
 		f.thetype = "INT"
-	case *string, *StringList:
+	case *string, **string, *StringList, *StringSet:
 		f.thetype = "STRING"
-	case *uint, *uint64, *UintList:
+	case *uint, *uint8, *uint16, *uint32, *uint64:
 		f.thetype = "UINT"
+	case **uint, **uint8, **uint16, **uint32, **uint64:
+		f.thetype = "UINT"
+	case *Uint8List, *Uint16List, *Uint32List, *UintList:
+		f.thetype = "UINT"
+	case *Uint8Set, *Uint16Set, *Uint32Set, *UintSet:
+		f.thetype = "UINT"
+	}
+}
+
+func (f *cliFlag) shorts() []string {
+	var shorts []string
+
+	if f.short != "" {
+		shorts = append(shorts, f.short)
+	}
+
+	for _, alias := range f.aliases {
+		if len(alias) == 1 {
+			shorts = append(shorts, alias)
+		}
+	}
+
+	return shorts
+}
+
+func (f *cliFlag) shown(deprecated bool) bool {
+	return !f.hidden && (f.deprecated == deprecated)
+}
+
+// sort will sort the flag's set, if configured to do so.
+func (f *cliFlag) sort() {
+	if s, ok := f.ptr.(sorter); f.sorted && ok {
+		s.Sort()
 	}
 }
 
@@ -374,17 +854,17 @@ func (f *cliFlag) String() string {
 	}
 
 	// Flags
-	sb.WriteString(f.column(Align && enoughRoom))
+	sb.WriteString(f.column(Align && enoughRoom, colorize()))
 
 	// Description
 	if Align && enoughRoom {
 		// Filler
-		fillto = TabWidth + colWidth.left - sb.Len()
+		fillto = TabWidth + colWidth.left - visibleLen(sb.String())
 		for range fillto {
 			sb.WriteString(" ")
 		}
 
-		lines = wrap(f.desc, colWidth.desc)
+		lines = wrap(f.description(false), colWidth.desc)
 		for i, line := range lines {
 			if i > 0 {
 				// Leading space plus filler
@@ -406,7 +886,7 @@ func (f *cliFlag) String() string {
 		sb.WriteString("\n")
 
 		//nolint:mnd // 2 is not a magic number
-		lines = wrap(f.desc, MaxWidth-(2*TabWidth))
+		lines = wrap(f.description(false), MaxWidth-(2*TabWidth))
 		for _, line := range lines {
 			// Leading space plus filler
 			for range 2 * TabWidth {
@@ -427,16 +907,12 @@ func (f *cliFlag) table() string {
 	var sb strings.Builder
 
 	// Option
-	if f.short != "" {
-		sb.WriteString("`-" + f.short + "`")
-
-		if f.long != "" {
+	for i, name := range f.names() {
+		if i > 0 {
 			sb.WriteString(", ")
 		}
-	}
 
-	if f.long != "" {
-		sb.WriteString("`--" + f.long + "`")
+		sb.WriteString("`" + dashed(name) + "`")
 	}
 
 	// Separator
@@ -444,14 +920,25 @@ func (f *cliFlag) table() string {
 
 	// Args
 	if f.thetype != "" {
-		sb.WriteString("`" + f.thetype + "`")
+		sb.WriteString("`" + f.arg("") + "`")
+	}
+
+	// Separator
+	sb.WriteString(" | ")
+
+	// Default
+	switch {
+	case f.nullable && !f.noDefault:
+		sb.WriteString("unset")
+	case f.defaultValue() != "":
+		sb.WriteString("`" + f.defaultValue() + "`")
 	}
 
 	// Separator
 	sb.WriteString(" | ")
 
 	// Description
-	sb.WriteString(f.desc + "\n")
+	sb.WriteString(f.description(true) + "\n")
 
 	return sb.String()
 }
@@ -462,27 +949,38 @@ func (f *cliFlag) updateMaxWidth() {
 	var sep int = 2
 	var sw int = 2
 
-	if f.long != "" {
-		//nolint:mnd // 2 is not a magic number
-		lw = 2 + len(f.long) + len(f.thetype)
-
-		if f.thetype != "" {
-			lw++
+	for i, long := range f.longs() {
+		if i > 0 {
+			lw += len(", ")
 		}
+
+		lw += len("--" + long)
 	}
 
-	dw = MaxWidth - TabWidth - sw - sep - lw - TabWidth
+	if lw > 0 {
+		lw += len(f.arg("="))
+	}
 
-	if dw < colWidth.desc {
-		colWidth.desc = dw
+	for i := range f.shorts() {
+		if i > 0 {
+			sw += len(", -x")
+		}
+	}
+
+	if sw > colWidth.short {
+		colWidth.short = sw
 	}
 
 	if lw > colWidth.long {
 		colWidth.long = lw
 	}
 
-	colWidth.short = 2
 	colWidth.left = colWidth.short + sep + colWidth.long
+	dw = MaxWidth - TabWidth - colWidth.left - TabWidth
+
+	if dw < colWidth.desc {
+		colWidth.desc = dw
+	}
 }
 
 func (f *cliFlag) validate() error {
@@ -498,6 +996,70 @@ func (f *cliFlag) validate() error {
 		return errors.Newf("invalid long flag \"--%s\"", f.long)
 	}
 
+	if _, ok := f.ptr.(*Counter); !ok {
+		if f.decrement {
+			return errors.Newf("%s is not a Counter", f.name())
+		}
+
+		if f.maxCount > 0 {
+			return errors.Newf("%s is not a Counter", f.name())
+		}
+	}
+
+	if (f.placeholder != "") && (f.thetype == "") {
+		return errors.Newf("%s does not take a value", f.name())
+	}
+
+	if f.optional && (f.thetype == "") {
+		return errors.Newf("%s does not take a value", f.name())
+	}
+
+	if f.optional && (len(f.choices) > 0) &&
+		!slices.Contains(f.choices, f.optVal) {
+		return errors.Newf(
+			"optional value %q for %s must be one of: %s",
+			f.optVal,
+			f.name(),
+			strings.Join(f.choices, ", "),
+		)
+	}
+
+	if ((f.minOccurs > 0) || (f.maxOccurs > 0)) && !f.isList {
+		return errors.Newf("%s is not a list or Counter", f.name())
+	}
+
+	if (f.maxOccurs > 0) && (f.minOccurs > f.maxOccurs) {
+		return errors.Newf("invalid occurrences for %s", f.name())
+	}
+
+	if f.noRepeat && f.isList {
+		return errors.Newf("%s is a list or Counter", f.name())
+	}
+
+	if _, ok := f.ptr.(sorter); f.sorted && !ok {
+		return errors.Newf("%s is not a set", f.name())
+	}
+
+	for _, alias := range f.aliases {
+		if (alias == "") || strings.HasPrefix(alias, "-") {
+			return errors.Newf(
+				"invalid alias %q for %s",
+				alias,
+				f.name(),
+			)
+		}
+	}
+
+	for i, name := range f.names() {
+		if slices.Contains(f.names()[:i], name) {
+			return errors.Newf("duplicate flag %s", dashed(name))
+		}
+
+		if flag.Lookup(name) != nil {
+			return errors.Newf("flag %s redefined", dashed(name))
+		}
+	}
+
 	if f.desc == "" {
 		if f.long != "" {
 			return errors.Newf("no description for \"--%s\"", f.long)
@@ -508,3 +1070,107 @@ func (f *cliFlag) validate() error {
 
 	return nil
 }
+
+// valueName will return the name of the flag's value, which is the
+// placeholder, if any, or the type label.
+func (f *cliFlag) valueName() string {
+	if f.placeholder != "" {
+		return f.placeholder
+	}
+
+	return f.thetype
+}
+
+// zeroDefault will return whether or not the default value is the
+// zero value of the flag's type, in the same manner as the flag
+// package, so that only "" is the zero value of a string flag.
+func (f *cliFlag) zeroDefault() bool {
+	var fl *flag.Flag
+	var ok bool
+	var t reflect.Type
+	var zero flag.Value
+
+	if fl = flag.Lookup(f.names()[0]); fl == nil {
+		return f.defVal == ""
+	}
+
+	if t = reflect.TypeOf(fl.Value); t.Kind() == reflect.Pointer {
+		zero, ok = reflect.New(t.Elem()).Interface().(flag.Value)
+	} else {
+		zero, ok = reflect.Zero(t).Interface().(flag.Value)
+	}
+
+	return ok && (f.defVal == zero.String())
+}
+
+// zsh will return the flag as a zsh _arguments spec.
+func (f *cliFlag) zsh() string {
+	var action string
+	var desc *strings.Replacer = strings.NewReplacer(
+		"'", "'\\''",
+		"[", "\\[",
+		"]", "\\]",
+	)
+	var names []string
+	var quote *strings.Replacer = strings.NewReplacer("'", "'\\''")
+	var sb strings.Builder
+	var specs []string
+
+	for _, n := range f.names() {
+		names = append(names, dashed(n))
+
+		switch {
+		case f.hint() == hintNone:
+			specs = append(specs, dashed(n))
+		case f.optional && (len(n) == 1):
+			specs = append(specs, dashed(n)+"-")
+		case f.optional:
+			specs = append(specs, dashed(n)+"=-")
+		case len(n) == 1:
+			specs = append(specs, dashed(n))
+		default:
+			specs = append(specs, dashed(n)+"=")
+		}
+	}
+
+	// Repeatable or mutually exclusive
+	if f.isList {
+		sb.WriteString("'*'")
+	} else {
+		sb.WriteString("'(" + strings.Join(names, " ") + ")'")
+	}
+
+	if len(specs) > 1 {
+		sb.WriteString("{" + strings.Join(specs, ",") + "}")
+	} else {
+		sb.WriteString(specs[0])
+	}
+
+	sb.WriteString("'[" + desc.Replace(f.desc) + "]")
+
+	switch f.hint() {
+	case hintNone:
+		sb.WriteString("'")
+		return sb.String()
+	case hintChoices:
+		action = strings.Join(f.choices, " ")
+		action = "(" + quote.Replace(action) + ")"
+	case hintDirs:
+		action = "_files -/"
+	case hintFiles:
+		action = "_files"
+	default:
+		action = " "
+	}
+
+	if f.optional {
+		sb.WriteString(":")
+	}
+
+	sb.WriteString(
+		":" + strings.ReplaceAll(f.valueName(), ":", "\\:"),
+	)
+	sb.WriteString(":" + action + "'")
+
+	return sb.String()
+}
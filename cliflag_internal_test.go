@@ -0,0 +1,55 @@
+package cli
+
+import "testing"
+
+func TestValidate(t *testing.T) {
+	var s string
+	var tests = []struct {
+		name    string
+		opts    []any
+		wantErr bool
+	}{
+		{
+			name: "optional value",
+			opts: []any{OptionalValue("always")},
+		},
+		{
+			name: "optional value is a choice",
+			opts: []any{
+				Choices("always", "auto", "never"),
+				OptionalValue("always"),
+			},
+		},
+		{
+			name: "optional value is not a choice",
+			opts: []any{
+				Choices("auto", "never"),
+				OptionalValue("always"),
+			},
+			wantErr: true,
+		},
+	}
+
+	isolateFlags(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var args []any = []any{&s, "color", "auto", "Color."}
+			var e error
+			var f *cliFlag
+
+			args = append(args, test.opts...)
+
+			if f, e = newFlag(args...); e != nil {
+				t.Fatal(e)
+			}
+
+			switch e = f.validate(); {
+			case test.wantErr && (e == nil):
+				t.Fatal("got no error, want one")
+			case !test.wantErr && (e != nil):
+				t.Fatalf("got error %v, want none", e)
+			}
+		})
+	}
+}
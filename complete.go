@@ -0,0 +1,312 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mjwhitta/errors"
+)
+
+const bashShim string = `# bash completion for {{name}}
+
+{{fn}}() {
+	local cmd="${COMP_LINE:0:COMP_POINT}"
+	local cur
+	local directive
+	local line
+	local trim=""
+	local -a out
+	local -a words
+
+	read -ra words <<<"$cmd"
+
+	if [[ "$cmd" == *[[:space:]] ]]; then
+		words+=("")
+	fi
+
+	cur="${words[-1]}"
+
+	# Bash treats = as a word break
+	if [[ "$cur" == -*=* ]] && [[ "$COMP_WORDBREAKS" == *=* ]]; then
+		trim="${cur%%=*}="
+	fi
+
+	mapfile -t out < <(
+		"${words[0]}" __complete "${words[@]:1}" 2>/dev/null
+	)
+
+	directive="${out[-1]}"
+	unset 'out[-1]'
+
+	COMPREPLY=()
+
+	for line in "${out[@]}"; do
+		COMPREPLY+=("${line#"$trim"}")
+	done
+
+	case "$directive" in
+	:dirs) COMPREPLY+=($(compgen -d -- "${cur#"$trim"}")) ;;
+	:files) COMPREPLY+=($(compgen -f -- "${cur#"$trim"}")) ;;
+	esac
+}
+
+complete -F {{fn}} {{name}}
+`
+
+const fishShim string = `# fish completion for {{name}}
+
+function {{fn}}
+	set -l args (commandline -opc)
+	set -l cur (commandline -ct)
+	set -l out ($args[1] __complete $args[2..-1] "$cur" 2>/dev/null)
+	set -l directive $out[-1]
+	set -l pre (string match -r -- '^-[^=]*=' "$cur"; or echo)
+	set -l val (string replace -r -- '^-[^=]*=' '' "$cur")
+
+	set -e out[-1]
+
+	for line in $out
+		echo $line
+	end
+
+	switch "$directive"
+		case :dirs
+			for dir in (__fish_complete_directories "$val")
+				echo "$pre$dir"
+			end
+		case :files
+			for file in (__fish_complete_path "$val")
+				echo "$pre$file"
+			end
+	end
+end
+
+complete -c {{name}} -f -a '({{fn}})'
+`
+
+const zshShim string = `#compdef {{name}}
+
+{{fn}}() {
+	local directive
+	local -a out
+
+	out=("${(@f)$(
+		"${words[1]}" __complete "${(@)words[2,CURRENT]}" 2>/dev/null
+	)}")
+
+	directive="${out[-1]}"
+	out=("${(@)out[1,-2]}")
+
+	# Complete only the value of --flag=value
+	if [[ "$PREFIX" == -*=* ]]; then
+		compset -P '*='
+		out=("${(@)out#*=}")
+	fi
+
+	if ((${#out})); then
+		compadd -Q -- "${out[@]}"
+	fi
+
+	case "$directive" in
+	:dirs) _files -/ ;;
+	:files) _files ;;
+	esac
+}
+
+if [[ "${funcstack[1]}" == "{{fn}}" ]]; then
+	{{fn}} "$@"
+else
+	compdef {{fn}} {{name}}
+fi
+`
+
+// Completer will return the candidates for a value being completed.
+// The provided prefix is the partial value typed so far. Candidates
+// that do not start with the prefix are ignored.
+type Completer func(prefix string) []string
+
+// CompleteArgs will set the completers used for positional args
+// during dynamic completion. The first completer is used for the
+// first positional arg, and so on, with the last completer used for
+// any remaining args. Without any completers, files are completed.
+func CompleteArgs(fns ...Completer) {
+	argCompleters = fns
+}
+
+// CompletionShim will write a thin completion script for the
+// specified shell (bash, fish, or zsh) to the provided io.Writer. The
+// script calls back into the program with the hidden __complete arg,
+// which allows for values to be completed by Completers. Shims are
+// also available via the hidden --completion-shim flag.
+func CompletionShim(shell string, w io.Writer) error {
+	var e error
+	var name string = filepath.Base(os.Args[0])
+	var fn string = "_" + nonIdent.ReplaceAllString(name, "_")
+	var shim string
+
+	switch shell {
+	case "bash":
+		shim = bashShim
+	case "fish":
+		shim = fishShim
+	case "zsh":
+		shim = zshShim
+	default:
+		return errors.Newf("unsupported shell %q", shell)
+	}
+
+	shim = strings.NewReplacer(
+		"{{fn}}", fn,
+		"{{name}}", name,
+	).Replace(shim)
+
+	if _, e = io.WriteString(w, shim); e != nil {
+		return e
+	}
+
+	return nil
+}
+
+// complete will process the provided args, without failing, and then
+// return the candidates for the last arg. The candidates are followed
+// by a directive telling the shell whether to also complete files or
+// directories.
+func complete(args []string) ([]string, valueHint) {
+	var cur string
+	var e error
+	var mv *MissingValueError
+	var name string
+	var ok bool
+	var positional bool
+	var rest []string
+	var val string
+
+	if len(args) == 0 {
+		args = []string{""}
+	}
+
+	cur = args[len(args)-1]
+
+	// Never warn about deprecated flags while completing
+	for _, f := range flags {
+		f.warned = true
+	}
+
+	rest, e = parse(args[:len(args)-1], true)
+
+	if (len(rest) > 0) && (rest[0] == "--") {
+		positional = true
+		rest = rest[1:]
+	}
+
+	switch {
+	case e != nil:
+		if mv, ok = e.(*MissingValueError); ok {
+			return completeValue(lookup(mv.Name), "", cur)
+		}
+
+		return nil, hintNone
+	case positional || (len(rest) > 0):
+		return completeArg(len(rest), cur)
+	case !strings.HasPrefix(cur, "-"):
+		return completeArg(0, cur)
+	case strings.Contains(cur, "="):
+		name, val, _ = strings.Cut(cur, "=")
+		return completeValue(lookup(name), name+"=", val)
+	default:
+		return completeFlags(cur), hintNone
+	}
+}
+
+func completeArg(n int, prefix string) ([]string, valueHint) {
+	if len(argCompleters) == 0 {
+		return nil, hintFiles
+	}
+
+	n = min(n, len(argCompleters)-1)
+
+	return matches(argCompleters[n](prefix), "", prefix), hintNone
+}
+
+func completeFlags(prefix string) []string {
+	var names []string
+
+	if !sort.SliceIsSorted(flags, less) {
+		sort.SliceStable(flags, less)
+	}
+
+	for _, f := range flags {
+		if !f.shown(false) {
+			continue
+		}
+
+		// Only complete flags once, unless repeatable
+		if !f.isList && (f.source == FromCommandLine) {
+			continue
+		}
+
+		for _, n := range f.names() {
+			names = append(names, dashed(n))
+		}
+	}
+
+	return matches(names, "", prefix)
+}
+
+func completeValue(
+	f *cliFlag, pre string, prefix string,
+) ([]string, valueHint) {
+	switch {
+	case f == nil:
+		return nil, hintFiles
+	case f.completer != nil:
+		return matches(f.completer(prefix), pre, prefix), hintNone
+	case len(f.choices) > 0:
+		return matches(f.choices, pre, prefix), hintNone
+	default:
+		return nil, f.hint()
+	}
+}
+
+// matches will return the candidates that start with the provided
+// prefix, prepending pre to each.
+func matches(cands []string, pre string, prefix string) []string {
+	var out []string
+
+	for _, c := range cands {
+		if strings.HasPrefix(c, prefix) {
+			out = append(out, pre+c)
+		}
+	}
+
+	return out
+}
+
+// runComplete will print the candidates for the provided args, one
+// per line, followed by the directive.
+func runComplete(args []string) {
+	var candidates []string
+	var hint valueHint
+	var sb strings.Builder
+
+	candidates, hint = complete(args)
+
+	for _, c := range candidates {
+		sb.WriteString(c + "\n")
+	}
+
+	switch hint {
+	case hintDirs:
+		sb.WriteString(":dirs\n")
+	case hintFiles:
+		sb.WriteString(":files\n")
+	default:
+		sb.WriteString(":none\n")
+	}
+
+	fmt.Print(sb.String())
+}
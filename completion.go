@@ -0,0 +1,297 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mjwhitta/errors"
+)
+
+type valueHint int
+
+const (
+	hintNone valueHint = iota
+	hintAny
+	hintChoices
+	hintDirs
+	hintFiles
+)
+
+var nonIdent *regexp.Regexp = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// Completion will write a completion script for the specified shell
+// (bash, fish, or zsh) to the provided io.Writer. The script is
+// generated from the defined flags, ignoring hidden and deprecated
+// flags, and is deterministic so that it can be committed. Flags
+// with choices complete those choices. Flags whose placeholder
+// contains DIR complete directories, while those containing FILE or
+// PATH complete files. Completion scripts are also available via the
+// hidden --completion flag.
+func Completion(shell string, w io.Writer) error {
+	var e error
+	var name string = filepath.Base(os.Args[0])
+	var script string
+
+	if !sort.SliceIsSorted(flags, less) {
+		sort.SliceStable(flags, less)
+	}
+
+	switch shell {
+	case "bash":
+		script = bashCompletion(name)
+	case "fish":
+		script = fishCompletion(name)
+	case "zsh":
+		script = zshCompletion(name)
+	default:
+		return errors.Newf("unsupported shell %q", shell)
+	}
+
+	if _, e = io.WriteString(w, script); e != nil {
+		return e
+	}
+
+	return nil
+}
+
+func bashCompletion(name string) string {
+	var fn string = "_" + nonIdent.ReplaceAllString(name, "_")
+	var pats []string
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# bash completion for %s\n\n", name)
+
+	// Helper to check if a flag was already provided
+	fmt.Fprintf(&sb, "%s_seen() {\n", fn)
+	sb.WriteString("\tlocal name word\n\n")
+	sb.WriteString(
+		"\tfor word in \"${COMP_WORDS[@]:1:COMP_CWORD-1}\"; do\n",
+	)
+	sb.WriteString("\t\tfor name in \"$@\"; do\n")
+	sb.WriteString("\t\t\t[[ \"$word\" == \"$name\" ]] && return 0\n")
+	sb.WriteString("\t\tdone\n")
+	sb.WriteString("\tdone\n\n")
+	sb.WriteString("\treturn 1\n")
+	sb.WriteString("}\n\n")
+
+	fmt.Fprintf(&sb, "%s() {\n", fn)
+	sb.WriteString("\tlocal cur=\"${COMP_WORDS[COMP_CWORD]}\"\n")
+	sb.WriteString("\tlocal opts=\"\"\n")
+	sb.WriteString("\tlocal prev=\"${COMP_WORDS[COMP_CWORD-1]}\"\n\n")
+
+	// Handle --flag=value, as = is a word break
+	sb.WriteString("\tif [[ \"$cur\" == \"=\" ]]; then\n")
+	sb.WriteString("\t\tcur=\"\"\n")
+	sb.WriteString("\t\tprev+=\"=\"\n")
+	sb.WriteString("\telif [[ \"$prev\" == \"=\" ]] &&")
+	sb.WriteString(" ((COMP_CWORD > 1)); then\n")
+	sb.WriteString("\t\tprev=\"${COMP_WORDS[COMP_CWORD-2]}=\"\n")
+	sb.WriteString("\tfi\n\n")
+
+	// Flag values
+	sb.WriteString("\tcase \"$prev\" in\n")
+
+	for _, f := range flags {
+		if !f.shown(false) || (f.hint() == hintNone) {
+			continue
+		}
+
+		pats = nil
+
+		for _, n := range f.names() {
+			if !f.optional {
+				pats = append(pats, dashed(n))
+			}
+
+			pats = append(pats, dashed(n)+"=")
+		}
+
+		fmt.Fprintf(&sb, "\t%s)\n", strings.Join(pats, " | "))
+
+		switch f.hint() {
+		case hintChoices:
+			fmt.Fprintf(
+				&sb,
+				"\t\tCOMPREPLY=($(compgen -W %s -- \"$cur\"))\n",
+				bashQuote(strings.Join(f.choices, " ")),
+			)
+		case hintDirs:
+			sb.WriteString(
+				"\t\tCOMPREPLY=($(compgen -d -- \"$cur\"))\n",
+			)
+		case hintFiles:
+			sb.WriteString(
+				"\t\tCOMPREPLY=($(compgen -f -- \"$cur\"))\n",
+			)
+		default:
+			sb.WriteString("\t\tCOMPREPLY=()\n")
+		}
+
+		sb.WriteString("\t\treturn\n")
+		sb.WriteString("\t\t;;\n")
+	}
+
+	sb.WriteString("\tesac\n\n")
+
+	// Positional args
+	sb.WriteString("\tif [[ \"$cur\" != -* ]]; then\n")
+	sb.WriteString("\t\tCOMPREPLY=($(compgen -f -- \"$cur\"))\n")
+	sb.WriteString("\t\treturn\n")
+	sb.WriteString("\tfi\n\n")
+
+	// Flags, skipping those that were provided, unless repeatable
+	for _, f := range flags {
+		if !f.shown(false) {
+			continue
+		}
+
+		pats = nil
+
+		for _, n := range f.names() {
+			pats = append(pats, dashed(n))
+		}
+
+		if f.isList {
+			fmt.Fprintf(
+				&sb,
+				"\topts+=\" %s\"\n",
+				strings.Join(pats, " "),
+			)
+		} else {
+			fmt.Fprintf(
+				&sb,
+				"\t%s_seen %s || opts+=\" %s\"\n",
+				fn,
+				strings.Join(pats, " "),
+				strings.Join(pats, " "),
+			)
+		}
+	}
+
+	sb.WriteString("\n\tCOMPREPLY=($(compgen -W \"$opts\" --")
+	sb.WriteString(" \"$cur\"))\n")
+	sb.WriteString("}\n\n")
+	fmt.Fprintf(&sb, "complete -F %s %s\n", fn, name)
+
+	return sb.String()
+}
+
+// bashQuote will return the provided text as a double-quoted bash
+// string.
+func bashQuote(text string) string {
+	var r *strings.Replacer = strings.NewReplacer(
+		"\\", "\\\\",
+		"\"", "\\\"",
+		"$", "\\$",
+		"`", "\\`",
+	)
+
+	return "\"" + r.Replace(text) + "\""
+}
+
+func fishCompletion(name string) string {
+	var args []string
+	var sb strings.Builder
+	var seen []string
+
+	fmt.Fprintf(&sb, "# fish completion for %s\n\n", name)
+
+	for _, f := range flags {
+		if !f.shown(false) {
+			continue
+		}
+
+		args = []string{"complete", "-c", fishQuote(name)}
+		seen = []string{"not", "__fish_seen_argument"}
+
+		for _, short := range f.shorts() {
+			args = append(args, "-s", fishQuote(short))
+			seen = append(seen, "-s", short)
+		}
+
+		for _, long := range f.longs() {
+			args = append(args, "-l", fishQuote(long))
+			seen = append(seen, "-l", long)
+		}
+
+		// Only complete flags once, unless repeatable
+		if !f.isList {
+			args = append(args, "-n")
+			args = append(args, fishQuote(strings.Join(seen, " ")))
+		}
+
+		if !f.optional {
+			switch f.hint() {
+			case hintAny:
+				args = append(args, "-x")
+			case hintChoices:
+				args = append(
+					args,
+					"-x",
+					"-a",
+					fishQuote(strings.Join(f.choices, " ")),
+				)
+			case hintDirs:
+				args = append(
+					args,
+					"-x",
+					"-a",
+					fishQuote("(__fish_complete_directories)"),
+				)
+			case hintFiles:
+				args = append(args, "-r", "-F")
+			}
+		}
+
+		args = append(args, "-d", fishQuote(f.desc))
+
+		sb.WriteString(strings.Join(args, " ") + "\n")
+	}
+
+	return sb.String()
+}
+
+// fishQuote will return the provided text as a single-quoted fish
+// string.
+func fishQuote(text string) string {
+	var r *strings.Replacer = strings.NewReplacer(
+		"\\", "\\\\",
+		"'", "\\'",
+	)
+
+	return "'" + r.Replace(text) + "'"
+}
+
+func zshCompletion(name string) string {
+	var fn string = "_" + nonIdent.ReplaceAllString(name, "_")
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "#compdef %s\n\n", name)
+	fmt.Fprintf(&sb, "%s() {\n", fn)
+	sb.WriteString("\t_arguments -S \\\n")
+
+	for _, f := range flags {
+		if f.shown(false) {
+			sb.WriteString("\t\t" + f.zsh() + " \\\n")
+		}
+	}
+
+	sb.WriteString("\t\t'*:file:_files'\n")
+	sb.WriteString("}\n\n")
+	fmt.Fprintf(
+		&sb,
+		"if [[ \"${funcstack[1]}\" == \"%s\" ]]; then\n",
+		fn,
+	)
+	fmt.Fprintf(&sb, "\t%s \"$@\"\n", fn)
+	sb.WriteString("else\n")
+	fmt.Fprintf(&sb, "\tcompdef %s %s\n", fn, name)
+	sb.WriteString("fi\n")
+
+	return sb.String()
+}
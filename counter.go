@@ -1,11 +1,28 @@
 package cli
 
-import "fmt"
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/mjwhitta/errors"
+)
 
 // Counter allows incrementing a value by counting the number of times
 // a flag was passed, as in: -v -v -v
+//
+// The value can also be set explicitly, as in: --verbose=3
 type Counter uint
 
+// Dec will decrement the Counter, stopping at zero.
+func (c *Counter) Dec() error {
+	if *c > 0 {
+		*c--
+	}
+
+	return nil
+}
+
 // Inc will increment the Counter.
 func (c *Counter) Inc() error {
 	*c++
@@ -18,12 +35,117 @@ func (c *Counter) IsBoolFlag() bool {
 	return true // Allow shorthand
 }
 
+// Level will return the slog.Level corresponding to the Counter,
+// which allows it to be used as a slog.Leveler. A Counter of zero
+// maps to slog.LevelWarn, one maps to slog.LevelInfo, and two maps to
+// slog.LevelDebug. Below is an example:
+//
+//	var verbose cli.Counter
+//
+//	cli.Flag(&verbose, "v", "verbose", "Increase verbosity.")
+//	cli.Parse()
+//
+//	slog.SetDefault(
+//		slog.New(
+//			slog.NewTextHandler(
+//				os.Stderr,
+//				&slog.HandlerOptions{Level: &verbose},
+//			),
+//		),
+//	)
+func (c *Counter) Level() slog.Level {
+	var step int = 4 // Distance between slog levels
+
+	return slog.LevelWarn - slog.Level(step*int(*c))
+}
+
 // String will return a string representation of the Counter.
 func (c *Counter) String() string {
-	return fmt.Sprintf("%d", c)
+	return fmt.Sprintf("%d", *c)
+}
+
+// Set will call Inc() when the flag is provided without a value.
+// Otherwise it will set the Counter to the provided value.
+func (c *Counter) Set(val string) error {
+	var e error
+	var v uint64
+
+	switch val {
+	case "", "true":
+		return c.Inc()
+	case "false":
+		*c = 0
+		return nil
+	}
+
+	if v, e = strconv.ParseUint(val, 0, strconv.IntSize); e != nil {
+		return errors.Newf("failed to parse %s as uint: %w", val, e)
+	}
+
+	*c = Counter(v)
+
+	return nil
+}
+
+// counterValue is the flag.Value used to register a Counter. It
+// allows for decrement flags and a maximum count.
+type counterValue struct {
+	c   *Counter
+	dec bool
+	max Counter
 }
 
-// Set will call Inc().
-func (c *Counter) Set(_ string) error {
-	return c.Inc()
+// IsBoolFlag allows counterValue to be called as --flag rather than
+// --flag=true.
+func (v *counterValue) IsBoolFlag() bool {
+	return true // Allow shorthand
+}
+
+// String will return a string representation of the counterValue.
+func (v *counterValue) String() string {
+	if v.c == nil {
+		return "0"
+	}
+
+	return v.c.String()
+}
+
+// Set will increment, decrement, or set the underlying Counter,
+// respecting the maximum count, if configured.
+func (v *counterValue) Set(val string) error {
+	var e error
+	var n Counter
+
+	if v.dec {
+		if val == "false" {
+			return nil
+		}
+
+		if e = n.Set(val); e != nil {
+			return e
+		}
+
+		*v.c -= min(n, *v.c)
+
+		return nil
+	}
+
+	n = *v.c
+
+	if e = n.Set(val); e != nil {
+		return e
+	}
+
+	if (v.max > 0) && (n > v.max) {
+		switch val {
+		case "", "true":
+			n = v.max
+		default:
+			return errors.Newf("%s exceeds maximum of %d", val, v.max)
+		}
+	}
+
+	*v.c = n
+
+	return nil
 }
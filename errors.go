@@ -0,0 +1,164 @@
+package cli
+
+import "strings"
+
+// DefinitionError is returned when a flag is defined incorrectly.
+type DefinitionError struct {
+	// Err is the underlying cause.
+	Err error
+
+	// Field is the struct field, if the flag was defined with Bind().
+	Field string
+
+	// Name is the flag name, if known.
+	Name string
+}
+
+// Error will return a string representation of the DefinitionError.
+func (e *DefinitionError) Error() string {
+	if e.Field == "" {
+		return e.Err.Error()
+	}
+
+	return "cli: field " + e.Field + ": " +
+		strings.TrimPrefix(e.Err.Error(), "cli: ")
+}
+
+// Unwrap will return the underlying cause.
+func (e *DefinitionError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidValueError is returned when a flag is provided a value that
+// can not be parsed.
+type InvalidValueError struct {
+	// Env is the environment variable the value came from, if any.
+	Env string
+
+	// Err is the underlying cause.
+	Err error
+
+	// Name is the flag name.
+	Name string
+
+	// Value is the raw value.
+	Value string
+}
+
+// Error will return a string representation of the
+// InvalidValueError.
+func (e *InvalidValueError) Error() string {
+	var cause string = strings.TrimPrefix(e.Err.Error(), "cli: ")
+
+	if e.Env != "" {
+		return "invalid value \"" + e.Value + "\" for $" + e.Env +
+			": " + cause
+	}
+
+	return "invalid value \"" + e.Value + "\" for flag " + e.Name +
+		": " + cause
+}
+
+// Unwrap will return the underlying cause.
+func (e *InvalidValueError) Unwrap() error {
+	return e.Err
+}
+
+// MissingFlagError is returned when a required flag is not provided.
+type MissingFlagError struct {
+	// Name is the flag name.
+	Name string
+}
+
+// Error will return a string representation of the
+// MissingFlagError.
+func (e *MissingFlagError) Error() string {
+	return "missing required flag " + e.Name
+}
+
+// MissingValueError is returned when a flag that requires a value
+// is provided without one.
+type MissingValueError struct {
+	// Name is the flag name.
+	Name string
+}
+
+// Error will return a string representation of the
+// MissingValueError.
+func (e *MissingValueError) Error() string {
+	return "flag needs an argument: " + e.Name
+}
+
+// OccurrenceError is returned when a list or Counter flag is provided
+// too few or too many times.
+type OccurrenceError struct {
+	// Count is the number of times the flag was provided.
+	Count uint
+
+	// Max is the maximum number of times allowed, or 0 if unlimited.
+	Max uint
+
+	// Min is the minimum number of times required.
+	Min uint
+
+	// Name is the flag name.
+	Name string
+}
+
+// Error will return a string representation of the OccurrenceError.
+func (e *OccurrenceError) Error() string {
+	var limit string = "at most " + times(e.Max)
+
+	if e.Count < e.Min {
+		limit = "at least " + times(e.Min)
+	}
+
+	return "flag " + e.Name + " provided " + times(e.Count) +
+		", expected " + limit
+}
+
+// RepeatedFlagError is returned when a flag is provided more than
+// once, in strict mode.
+type RepeatedFlagError struct {
+	// Name is the flag name, as provided the second time.
+	Name string
+}
+
+// Error will return a string representation of the
+// RepeatedFlagError.
+func (e *RepeatedFlagError) Error() string {
+	return "flag provided more than once: " + e.Name
+}
+
+// SyntaxError is returned when an arg looks like a flag, but is
+// malformed, such as ---flag or -=value.
+type SyntaxError struct {
+	// Arg is the raw arg.
+	Arg string
+}
+
+// Error will return a string representation of the SyntaxError.
+func (e *SyntaxError) Error() string {
+	return "bad flag syntax: " + e.Arg
+}
+
+// UnknownFlagError is returned when a flag is provided that was
+// never defined.
+type UnknownFlagError struct {
+	// Name is the flag name, as provided.
+	Name string
+
+	// Suggestions are the closest matching flags, if any.
+	Suggestions []string
+}
+
+// Error will return a string representation of the
+// UnknownFlagError.
+func (e *UnknownFlagError) Error() string {
+	if len(e.Suggestions) == 0 {
+		return "unknown flag " + e.Name
+	}
+
+	return "unknown flag " + e.Name + ", did you mean " +
+		strings.Join(e.Suggestions, " or ") + "?"
+}
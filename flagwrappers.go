@@ -1,6 +1,10 @@
 package cli
 
-import "flag"
+import (
+	"flag"
+	"fmt"
+	"os"
+)
 
 // Arg wraps flag.Arg(i int).
 func Arg(i int) string {
@@ -22,18 +26,181 @@ func NFlag() int {
 	return flag.NFlag()
 }
 
-// Parse will run flag.Parse() and then check for the --help or
-// --readme flags.
+// Parse will call ParseArgs() with the command line args. If an
+// error occurs, it is printed along with Usage(). If the error is an
+// UnknownFlagError, the error alone is printed, as it is most likely
+// a typo. The same is true for errors that do not come from parsing,
+// such as a stale README.md with --readme-check.
 func Parse() {
-	flag.Parse()
+	var e error
+	var exit int = 127
+
+	if e = ParseArgs(os.Args[1:]); e == nil {
+		return
+	}
+
+	fmt.Fprintln(os.Stderr, e.Error())
+
+	switch e.(type) {
+	case *InvalidValueError, *MissingFlagError, *MissingValueError:
+		flag.Usage()
+	case *OccurrenceError, *RepeatedFlagError, *SyntaxError:
+		flag.Usage()
+	default:
+		// Keep it concise if it was just a typo, or not a usage error
+		os.Exit(exit)
+	}
+}
+
+// ParseArgs will process the provided args in the same manner as
+// flag.Parse() and then check for the --completion,
+// --completion-shim, --help, --help-json, --man, --readme, or
+// --readme-check flags. If the first arg is __complete, completion
+// candidates for the remaining args are printed instead. Any flags
+// not provided will then be read from their environment variables,
+// if configured, and required flags and occurrence limits are
+// verified. A warning is printed for any deprecated flags that are
+// used. Errors are returned rather than printed, and will be one of
+// InvalidValueError, MissingFlagError, MissingValueError,
+// OccurrenceError, RepeatedFlagError, SyntaxError, or
+// UnknownFlagError, unless they come from the --readme or similar
+// flags.
+func ParseArgs(args []string) error {
+	var e error
+
+	if (len(args) > 0) && (args[0] == "__complete") {
+		runComplete(args[1:])
+		os.Exit(0)
+	}
+
+	if args, e = parse(args, false); e != nil {
+		return e
+	}
+
+	// Mark flags as parsed and store the remaining args
+	_ = flag.CommandLine.Parse(append([]string{"--"}, args...))
 
 	if help {
 		Usage(0)
 	}
 
-	if readme {
+	if helpJSON {
+		if e = HelpJSON(os.Stdout); e != nil {
+			return e
+		}
+
+		os.Exit(0)
+	}
+
+	switch readme {
+	case "":
+	case "-":
 		Readme()
+	default:
+		if e = WriteReadme(readme); e != nil {
+			return e
+		}
+
+		os.Exit(0)
+	}
+
+	if readmeCheck != "" {
+		if e = CheckReadme(readmeCheck); e != nil {
+			return e
+		}
+
+		os.Exit(0)
+	}
+
+	if man {
+		if e = Man(os.Stdout); e != nil {
+			return e
+		}
+
+		os.Exit(0)
+	}
+
+	if completion != "" {
+		if e = Completion(completion, os.Stdout); e != nil {
+			return e
+		}
+
+		os.Exit(0)
 	}
+
+	if compShim != "" {
+		if e = CompletionShim(compShim, os.Stdout); e != nil {
+			return e
+		}
+
+		os.Exit(0)
+	}
+
+	if e = parseEnv(); e != nil {
+		return e
+	}
+
+	if e = checkRequired(); e != nil {
+		return e
+	}
+
+	return checkOccurrences()
+}
+
+func checkOccurrences() error {
+	for _, f := range flags {
+		if (f.count >= f.minOccurs) &&
+			((f.maxOccurs == 0) || (f.count <= f.maxOccurs)) {
+			continue
+		}
+
+		return &OccurrenceError{
+			Count: f.count,
+			Max:   f.maxOccurs,
+			Min:   f.minOccurs,
+			Name:  f.name(),
+		}
+	}
+
+	return nil
+}
+
+func checkRequired() error {
+	for _, f := range flags {
+		if f.required && (f.source == FromDefault) {
+			return &MissingFlagError{Name: f.name()}
+		}
+	}
+
+	return nil
+}
+
+func parseEnv() error {
+	var e error
+	var ok bool
+	var val string
+
+	for _, f := range flags {
+		// Never override the command line, but do override config
+		if (f.env == "") || (f.source >= FromEnv) {
+			continue
+		}
+
+		if val, ok = os.LookupEnv(f.env); !ok {
+			continue
+		}
+
+		if e = f.set(f.names()[0], val, FromEnv); e != nil {
+			return &InvalidValueError{
+				Env:   f.env,
+				Err:   e,
+				Name:  f.name(),
+				Value: val,
+			}
+		}
+	}
+
+	return nil
 }
 
 // Parsed wraps flag.Parsed().
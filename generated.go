@@ -3,7 +3,9 @@ package cli
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/mjwhitta/errors"
 )
@@ -12,10 +14,26 @@ import (
 // --flag=float1 --flag=float2
 type FloatList []float64
 
+// Float32List allows setting a value multiple times, as in:
+// --flag=float1 --flag=float2
+type Float32List []float32
+
 // IntList allows setting a value multiple times, as in:
 // --flag=int1 --flag=int2
 type IntList []int64
 
+// Int8List allows setting a value multiple times, as in:
+// --flag=int1 --flag=int2
+type Int8List []int8
+
+// Int16List allows setting a value multiple times, as in:
+// --flag=int1 --flag=int2
+type Int16List []int16
+
+// Int32List allows setting a value multiple times, as in:
+// --flag=int1 --flag=int2
+type Int32List []int32
+
 // StringList allows setting a value multiple times, as in:
 // --flag=string1 --flag=string2
 type StringList []string
@@ -24,6 +42,62 @@ type StringList []string
 // --flag=uint1 --flag=uint2
 type UintList []uint64
 
+// Uint8List allows setting a value multiple times, as in:
+// --flag=uint1 --flag=uint2
+type Uint8List []uint8
+
+// Uint16List allows setting a value multiple times, as in:
+// --flag=uint1 --flag=uint2
+type Uint16List []uint16
+
+// Uint32List allows setting a value multiple times, as in:
+// --flag=uint1 --flag=uint2
+type Uint32List []uint32
+
+// FloatSet allows setting unique values multiple times, as in:
+// --flag=float1 --flag=float2
+type FloatSet []float64
+
+// Float32Set allows setting unique values multiple times, as in:
+// --flag=float1 --flag=float2
+type Float32Set []float32
+
+// IntSet allows setting unique values multiple times, as in:
+// --flag=int1 --flag=int2
+type IntSet []int64
+
+// Int8Set allows setting unique values multiple times, as in:
+// --flag=int1 --flag=int2
+type Int8Set []int8
+
+// Int16Set allows setting unique values multiple times, as in:
+// --flag=int1 --flag=int2
+type Int16Set []int16
+
+// Int32Set allows setting unique values multiple times, as in:
+// --flag=int1 --flag=int2
+type Int32Set []int32
+
+// StringSet allows setting unique values multiple times, as in:
+// --flag=string1 --flag=string2
+type StringSet []string
+
+// UintSet allows setting unique values multiple times, as in:
+// --flag=uint1 --flag=uint2
+type UintSet []uint64
+
+// Uint8Set allows setting unique values multiple times, as in:
+// --flag=uint1 --flag=uint2
+type Uint8Set []uint8
+
+// Uint16Set allows setting unique values multiple times, as in:
+// --flag=uint1 --flag=uint2
+type Uint16Set []uint16
+
+// Uint32Set allows setting unique values multiple times, as in:
+// --flag=uint1 --flag=uint2
+type Uint32Set []uint32
+
 // String returns a string representation of the FloatList.
 func (list *FloatList) String() string {
 	if len(*list) == 0 {
@@ -47,6 +121,29 @@ func (list *FloatList) Set(val string) error {
 	return nil
 }
 
+// String returns a string representation of the Float32List.
+func (list *Float32List) String() string {
+	if len(*list) == 0 {
+		return "[]"
+	}
+
+	return fmt.Sprint(*list)
+}
+
+// Set appends a float32 to a Float32List.
+func (list *Float32List) Set(val string) error {
+	var e error
+	var v float64
+
+	if v, e = strconv.ParseFloat(val, 32); e != nil {
+		return errors.Newf("failed to parse %s as float32: %w", val, e)
+	}
+
+	(*list) = append(*list, float32(v))
+
+	return nil
+}
+
 // String returns a string representation of the IntList.
 func (list *IntList) String() string {
 	if len(*list) == 0 {
@@ -70,6 +167,75 @@ func (list *IntList) Set(val string) error {
 	return nil
 }
 
+// String returns a string representation of the Int8List.
+func (list *Int8List) String() string {
+	if len(*list) == 0 {
+		return "[]"
+	}
+
+	return fmt.Sprint(*list)
+}
+
+// Set appends a int8 to a Int8List.
+func (list *Int8List) Set(val string) error {
+	var e error
+	var v int64
+
+	if v, e = strconv.ParseInt(val, 0, 8); e != nil {
+		return errors.Newf("failed to parse %s as int8: %w", val, e)
+	}
+
+	(*list) = append(*list, int8(v))
+
+	return nil
+}
+
+// String returns a string representation of the Int16List.
+func (list *Int16List) String() string {
+	if len(*list) == 0 {
+		return "[]"
+	}
+
+	return fmt.Sprint(*list)
+}
+
+// Set appends a int16 to a Int16List.
+func (list *Int16List) Set(val string) error {
+	var e error
+	var v int64
+
+	if v, e = strconv.ParseInt(val, 0, 16); e != nil {
+		return errors.Newf("failed to parse %s as int16: %w", val, e)
+	}
+
+	(*list) = append(*list, int16(v))
+
+	return nil
+}
+
+// String returns a string representation of the Int32List.
+func (list *Int32List) String() string {
+	if len(*list) == 0 {
+		return "[]"
+	}
+
+	return fmt.Sprint(*list)
+}
+
+// Set appends a int32 to a Int32List.
+func (list *Int32List) Set(val string) error {
+	var e error
+	var v int64
+
+	if v, e = strconv.ParseInt(val, 0, 32); e != nil {
+		return errors.Newf("failed to parse %s as int32: %w", val, e)
+	}
+
+	(*list) = append(*list, int32(v))
+
+	return nil
+}
+
 // String returns a string representation of the StringList.
 func (list *StringList) String() string {
 	if len(*list) == 0 {
@@ -108,3 +274,472 @@ func (list *UintList) Set(val string) error {
 
 	return nil
 }
+
+// String returns a string representation of the Uint8List.
+func (list *Uint8List) String() string {
+	if len(*list) == 0 {
+		return "[]"
+	}
+
+	return fmt.Sprint(*list)
+}
+
+// Set appends a uint8 to a Uint8List.
+func (list *Uint8List) Set(val string) error {
+	var e error
+	var v uint64
+
+	if v, e = strconv.ParseUint(val, 0, 8); e != nil {
+		return errors.Newf("failed to parse %s as uint8: %w", val, e)
+	}
+
+	(*list) = append(*list, uint8(v))
+
+	return nil
+}
+
+// String returns a string representation of the Uint16List.
+func (list *Uint16List) String() string {
+	if len(*list) == 0 {
+		return "[]"
+	}
+
+	return fmt.Sprint(*list)
+}
+
+// Set appends a uint16 to a Uint16List.
+func (list *Uint16List) Set(val string) error {
+	var e error
+	var v uint64
+
+	if v, e = strconv.ParseUint(val, 0, 16); e != nil {
+		return errors.Newf("failed to parse %s as uint16: %w", val, e)
+	}
+
+	(*list) = append(*list, uint16(v))
+
+	return nil
+}
+
+// String returns a string representation of the Uint32List.
+func (list *Uint32List) String() string {
+	if len(*list) == 0 {
+		return "[]"
+	}
+
+	return fmt.Sprint(*list)
+}
+
+// Set appends a uint32 to a Uint32List.
+func (list *Uint32List) Set(val string) error {
+	var e error
+	var v uint64
+
+	if v, e = strconv.ParseUint(val, 0, 32); e != nil {
+		return errors.Newf("failed to parse %s as uint32: %w", val, e)
+	}
+
+	(*list) = append(*list, uint32(v))
+
+	return nil
+}
+
+// Contains returns whether or not val is in the FloatSet.
+func (set *FloatSet) Contains(val float64) bool {
+	return slices.Contains(*set, val)
+}
+
+// Set adds a float to a FloatSet, if not already present.
+func (set *FloatSet) Set(val string) error {
+	var e error
+	var v float64
+
+	if v, e = strconv.ParseFloat(val, 64); e != nil {
+		return errors.Newf("failed to parse %s as float: %w", val, e)
+	}
+
+	if !slices.Contains(*set, v) {
+		(*set) = append(*set, v)
+	}
+
+	return nil
+}
+
+// Sort sorts the FloatSet in increasing order.
+func (set *FloatSet) Sort() {
+	slices.Sort(*set)
+}
+
+// String returns a string representation of the FloatSet.
+func (set *FloatSet) String() string {
+	var out []string = make([]string, 0, len(*set))
+
+	for _, v := range *set {
+		out = append(out, fmt.Sprint(v))
+	}
+
+	return "{" + strings.Join(out, ", ") + "}"
+}
+
+// Contains returns whether or not val is in the Float32Set.
+func (set *Float32Set) Contains(val float32) bool {
+	return slices.Contains(*set, val)
+}
+
+// Set adds a float32 to a Float32Set, if not already present.
+func (set *Float32Set) Set(val string) error {
+	var e error
+	var v float64
+
+	if v, e = strconv.ParseFloat(val, 32); e != nil {
+		return errors.Newf("failed to parse %s as float32: %w", val, e)
+	}
+
+	if !slices.Contains(*set, float32(v)) {
+		(*set) = append(*set, float32(v))
+	}
+
+	return nil
+}
+
+// Sort sorts the Float32Set in increasing order.
+func (set *Float32Set) Sort() {
+	slices.Sort(*set)
+}
+
+// String returns a string representation of the Float32Set.
+func (set *Float32Set) String() string {
+	var out []string = make([]string, 0, len(*set))
+
+	for _, v := range *set {
+		out = append(out, fmt.Sprint(v))
+	}
+
+	return "{" + strings.Join(out, ", ") + "}"
+}
+
+// Contains returns whether or not val is in the IntSet.
+func (set *IntSet) Contains(val int64) bool {
+	return slices.Contains(*set, val)
+}
+
+// Set adds a int to a IntSet, if not already present.
+func (set *IntSet) Set(val string) error {
+	var e error
+	var v int64
+
+	if v, e = strconv.ParseInt(val, 0, 64); e != nil {
+		return errors.Newf("failed to parse %s as int: %w", val, e)
+	}
+
+	if !slices.Contains(*set, v) {
+		(*set) = append(*set, v)
+	}
+
+	return nil
+}
+
+// Sort sorts the IntSet in increasing order.
+func (set *IntSet) Sort() {
+	slices.Sort(*set)
+}
+
+// String returns a string representation of the IntSet.
+func (set *IntSet) String() string {
+	var out []string = make([]string, 0, len(*set))
+
+	for _, v := range *set {
+		out = append(out, fmt.Sprint(v))
+	}
+
+	return "{" + strings.Join(out, ", ") + "}"
+}
+
+// Contains returns whether or not val is in the Int8Set.
+func (set *Int8Set) Contains(val int8) bool {
+	return slices.Contains(*set, val)
+}
+
+// Set adds a int8 to a Int8Set, if not already present.
+func (set *Int8Set) Set(val string) error {
+	var e error
+	var v int64
+
+	if v, e = strconv.ParseInt(val, 0, 8); e != nil {
+		return errors.Newf("failed to parse %s as int8: %w", val, e)
+	}
+
+	if !slices.Contains(*set, int8(v)) {
+		(*set) = append(*set, int8(v))
+	}
+
+	return nil
+}
+
+// Sort sorts the Int8Set in increasing order.
+func (set *Int8Set) Sort() {
+	slices.Sort(*set)
+}
+
+// String returns a string representation of the Int8Set.
+func (set *Int8Set) String() string {
+	var out []string = make([]string, 0, len(*set))
+
+	for _, v := range *set {
+		out = append(out, fmt.Sprint(v))
+	}
+
+	return "{" + strings.Join(out, ", ") + "}"
+}
+
+// Contains returns whether or not val is in the Int16Set.
+func (set *Int16Set) Contains(val int16) bool {
+	return slices.Contains(*set, val)
+}
+
+// Set adds a int16 to a Int16Set, if not already present.
+func (set *Int16Set) Set(val string) error {
+	var e error
+	var v int64
+
+	if v, e = strconv.ParseInt(val, 0, 16); e != nil {
+		return errors.Newf("failed to parse %s as int16: %w", val, e)
+	}
+
+	if !slices.Contains(*set, int16(v)) {
+		(*set) = append(*set, int16(v))
+	}
+
+	return nil
+}
+
+// Sort sorts the Int16Set in increasing order.
+func (set *Int16Set) Sort() {
+	slices.Sort(*set)
+}
+
+// String returns a string representation of the Int16Set.
+func (set *Int16Set) String() string {
+	var out []string = make([]string, 0, len(*set))
+
+	for _, v := range *set {
+		out = append(out, fmt.Sprint(v))
+	}
+
+	return "{" + strings.Join(out, ", ") + "}"
+}
+
+// Contains returns whether or not val is in the Int32Set.
+func (set *Int32Set) Contains(val int32) bool {
+	return slices.Contains(*set, val)
+}
+
+// Set adds a int32 to a Int32Set, if not already present.
+func (set *Int32Set) Set(val string) error {
+	var e error
+	var v int64
+
+	if v, e = strconv.ParseInt(val, 0, 32); e != nil {
+		return errors.Newf("failed to parse %s as int32: %w", val, e)
+	}
+
+	if !slices.Contains(*set, int32(v)) {
+		(*set) = append(*set, int32(v))
+	}
+
+	return nil
+}
+
+// Sort sorts the Int32Set in increasing order.
+func (set *Int32Set) Sort() {
+	slices.Sort(*set)
+}
+
+// String returns a string representation of the Int32Set.
+func (set *Int32Set) String() string {
+	var out []string = make([]string, 0, len(*set))
+
+	for _, v := range *set {
+		out = append(out, fmt.Sprint(v))
+	}
+
+	return "{" + strings.Join(out, ", ") + "}"
+}
+
+// Contains returns whether or not val is in the StringSet.
+func (set *StringSet) Contains(val string) bool {
+	return slices.Contains(*set, val)
+}
+
+// Set adds a string to a StringSet, if not already present.
+func (set *StringSet) Set(val string) error {
+	if !slices.Contains(*set, val) {
+		(*set) = append(*set, val)
+	}
+
+	return nil
+}
+
+// Sort sorts the StringSet in increasing order.
+func (set *StringSet) Sort() {
+	slices.Sort(*set)
+}
+
+// String returns a string representation of the StringSet.
+func (set *StringSet) String() string {
+	var out []string = make([]string, 0, len(*set))
+
+	for _, v := range *set {
+		out = append(out, fmt.Sprint(v))
+	}
+
+	return "{" + strings.Join(out, ", ") + "}"
+}
+
+// Contains returns whether or not val is in the UintSet.
+func (set *UintSet) Contains(val uint64) bool {
+	return slices.Contains(*set, val)
+}
+
+// Set adds a uint to a UintSet, if not already present.
+func (set *UintSet) Set(val string) error {
+	var e error
+	var v uint64
+
+	if v, e = strconv.ParseUint(val, 0, 64); e != nil {
+		return errors.Newf("failed to parse %s as uint: %w", val, e)
+	}
+
+	if !slices.Contains(*set, v) {
+		(*set) = append(*set, v)
+	}
+
+	return nil
+}
+
+// Sort sorts the UintSet in increasing order.
+func (set *UintSet) Sort() {
+	slices.Sort(*set)
+}
+
+// String returns a string representation of the UintSet.
+func (set *UintSet) String() string {
+	var out []string = make([]string, 0, len(*set))
+
+	for _, v := range *set {
+		out = append(out, fmt.Sprint(v))
+	}
+
+	return "{" + strings.Join(out, ", ") + "}"
+}
+
+// Contains returns whether or not val is in the Uint8Set.
+func (set *Uint8Set) Contains(val uint8) bool {
+	return slices.Contains(*set, val)
+}
+
+// Set adds a uint8 to a Uint8Set, if not already present.
+func (set *Uint8Set) Set(val string) error {
+	var e error
+	var v uint64
+
+	if v, e = strconv.ParseUint(val, 0, 8); e != nil {
+		return errors.Newf("failed to parse %s as uint8: %w", val, e)
+	}
+
+	if !slices.Contains(*set, uint8(v)) {
+		(*set) = append(*set, uint8(v))
+	}
+
+	return nil
+}
+
+// Sort sorts the Uint8Set in increasing order.
+func (set *Uint8Set) Sort() {
+	slices.Sort(*set)
+}
+
+// String returns a string representation of the Uint8Set.
+func (set *Uint8Set) String() string {
+	var out []string = make([]string, 0, len(*set))
+
+	for _, v := range *set {
+		out = append(out, fmt.Sprint(v))
+	}
+
+	return "{" + strings.Join(out, ", ") + "}"
+}
+
+// Contains returns whether or not val is in the Uint16Set.
+func (set *Uint16Set) Contains(val uint16) bool {
+	return slices.Contains(*set, val)
+}
+
+// Set adds a uint16 to a Uint16Set, if not already present.
+func (set *Uint16Set) Set(val string) error {
+	var e error
+	var v uint64
+
+	if v, e = strconv.ParseUint(val, 0, 16); e != nil {
+		return errors.Newf("failed to parse %s as uint16: %w", val, e)
+	}
+
+	if !slices.Contains(*set, uint16(v)) {
+		(*set) = append(*set, uint16(v))
+	}
+
+	return nil
+}
+
+// Sort sorts the Uint16Set in increasing order.
+func (set *Uint16Set) Sort() {
+	slices.Sort(*set)
+}
+
+// String returns a string representation of the Uint16Set.
+func (set *Uint16Set) String() string {
+	var out []string = make([]string, 0, len(*set))
+
+	for _, v := range *set {
+		out = append(out, fmt.Sprint(v))
+	}
+
+	return "{" + strings.Join(out, ", ") + "}"
+}
+
+// Contains returns whether or not val is in the Uint32Set.
+func (set *Uint32Set) Contains(val uint32) bool {
+	return slices.Contains(*set, val)
+}
+
+// Set adds a uint32 to a Uint32Set, if not already present.
+func (set *Uint32Set) Set(val string) error {
+	var e error
+	var v uint64
+
+	if v, e = strconv.ParseUint(val, 0, 32); e != nil {
+		return errors.Newf("failed to parse %s as uint32: %w", val, e)
+	}
+
+	if !slices.Contains(*set, uint32(v)) {
+		(*set) = append(*set, uint32(v))
+	}
+
+	return nil
+}
+
+// Sort sorts the Uint32Set in increasing order.
+func (set *Uint32Set) Sort() {
+	slices.Sort(*set)
+}
+
+// String returns a string representation of the Uint32Set.
+func (set *Uint32Set) String() string {
+	var out []string = make([]string, 0, len(*set))
+
+	for _, v := range *set {
+		out = append(out, fmt.Sprint(v))
+	}
+
+	return "{" + strings.Join(out, ", ") + "}"
+}
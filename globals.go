@@ -18,15 +18,40 @@ var (
 	// Banner is the initial Usage() line.
 	Banner string = os.Args[0] + " [OPTIONS]"
 
+	// BacktickPlaceholders determines if the first backticked word
+	// in a flag's description is used as the placeholder for its
+	// value, as with flag.UnquoteUsage().
+	BacktickPlaceholders bool
+
 	// BugEmail is the configured email to send bug reports to.
 	BugEmail string
 
+	// HelpTemplate is a text/template used by Usage() instead of
+	// DefaultHelpTemplate, if set. It can redefine only the header,
+	// options, or extra blocks, leaving the rest unchanged. See
+	// TemplateData for the available data and funcs.
+	HelpTemplate string
+
 	// MaxWidth is how wide the Usage() message should be.
 	MaxWidth int = 80
 
+	// ReadmeTemplate is a text/template used by Readme() instead of
+	// DefaultReadmeTemplate, if set. See TemplateData for the
+	// available data and funcs.
+	ReadmeTemplate string
+
 	// SeeAlso is a list of related tools.
 	SeeAlso []string
 
+	// ShowDeprecated determines if deprecated flags are listed in a
+	// separate section of Usage() and the README.md.
+	ShowDeprecated bool
+
+	// Strict determines if providing a flag that is not a list or
+	// Counter more than once is an error. It can also be enabled for
+	// individual flags with the NoRepeat() option.
+	Strict bool
+
 	// TabWidth determines the indentation size.
 	TabWidth int = 4
 
@@ -34,16 +59,22 @@ var (
 	// --readme flag.
 	Title string
 
-	colWidth = columnWidth{
+	argCompleters []Completer
+	colWidth      = columnWidth{
 		desc:  1024, //nolint:mnd // Start with big number
 		left:  0,
 		long:  0,
 		short: 0,
 	}
-	exitStatus string
-	flags      []*cliFlag
-	help       bool
-	info       string
-	readme     bool
-	sections   []section
+	compShim    string
+	completion  string
+	exitStatus  string
+	flags       []*cliFlag
+	help        bool
+	helpJSON    bool
+	info        string
+	man         bool
+	readme      string
+	readmeCheck string
+	sections    []section
 )
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+)
+
+type spec struct {
+	Authors    []string      `json:"authors"`
+	Banner     string        `json:"banner"`
+	BugEmail   string        `json:"bug_email"`
+	ExitStatus string        `json:"exit_status"`
+	Flags      []FlagInfo    `json:"flags"`
+	Info       string        `json:"info"`
+	Sections   []SectionInfo `json:"sections"`
+	SeeAlso    []string      `json:"see_also"`
+	Title      string        `json:"title"`
+}
+
+// HelpJSON will write a JSON document describing the whole cli,
+// including hidden flags, to the provided io.Writer. The document is
+// also available via the hidden --help-json flag. Flags are described
+// with the same fields as FlagInfo, and custom sections with the same
+// fields as SectionInfo.
+func HelpJSON(w io.Writer) error {
+	var enc *json.Encoder = json.NewEncoder(w)
+	var s spec = spec{
+		Authors:    []string{},
+		Banner:     Banner,
+		BugEmail:   BugEmail,
+		ExitStatus: exitStatus,
+		Flags:      slices.Collect(Flags()),
+		Info:       info,
+		Sections:   []SectionInfo{},
+		SeeAlso:    []string{},
+		Title:      Title,
+	}
+
+	s.Authors = append(s.Authors, Authors...)
+	s.Sections = append(s.Sections, slices.Collect(Sections())...)
+	s.SeeAlso = append(s.SeeAlso, SeeAlso...)
+
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(s)
+}
@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"flag"
+	"iter"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// FlagInfo is a read-only view of a defined flag.
+type FlagInfo struct {
+	// Choices are the values the flag is limited to, if any.
+	Choices []string `json:"choices,omitempty"`
+
+	// Default is the string representation of the default value.
+	Default string `json:"default"`
+
+	// Deprecated is whether or not the flag is deprecated.
+	Deprecated bool `json:"deprecated"`
+
+	// DeprecationMessage is the message provided with Deprecated().
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+
+	// Description is the description of the flag.
+	Description string `json:"description"`
+
+	// Env is the environment variable used as a fallback, if any.
+	Env string `json:"env,omitempty"`
+
+	// Hidden is whether or not the flag is hidden from Usage() and
+	// --readme.
+	Hidden bool `json:"hidden"`
+
+	// IsCounter is whether or not the flag is a Counter.
+	IsCounter bool `json:"is_counter"`
+
+	// IsList is whether or not the flag can be provided multiple
+	// times to build a list.
+	IsList bool `json:"is_list"`
+
+	// Longs are the long names of the flag, without dashes.
+	Longs []string `json:"longs,omitempty"`
+
+	// MaxOccurrences is the maximum number of times the flag can be
+	// provided, or 0 if unlimited.
+	MaxOccurrences uint `json:"max_occurrences,omitempty"`
+
+	// MinOccurrences is the minimum number of times the flag must be
+	// provided.
+	MinOccurrences uint `json:"min_occurrences,omitempty"`
+
+	// Name is the primary name of the flag, with dashes.
+	Name string `json:"name"`
+
+	// Placeholder is the name of the flag's value in Usage(), if it
+	// differs from Type.
+	Placeholder string `json:"placeholder,omitempty"`
+
+	// ReplacedBy is the flag that replaces this deprecated flag, if
+	// any.
+	ReplacedBy string `json:"replaced_by,omitempty"`
+
+	// Required is whether or not the flag must be provided.
+	Required bool `json:"required"`
+
+	// Shorts are the short names of the flag, without dashes.
+	Shorts []string `json:"shorts,omitempty"`
+
+	// Source is where the current value came from.
+	Source FlagSource `json:"-"`
+
+	// Type is the label for the flag's value, such as INT, or empty
+	// if the flag does not take a value.
+	Type string `json:"type,omitempty"`
+
+	// Value is the string representation of the value at the time
+	// the FlagInfo was created.
+	Value string `json:"-"`
+}
+
+// SectionInfo is a read-only view of a custom section.
+type SectionInfo struct {
+	// AlignOn is the separator used to align key/value pairs, if
+	// the section was created with SectionAligned().
+	AlignOn string `json:"align_on,omitempty"`
+
+	// Pairs are the key/value pairs of the section, if the section
+	// was created with SectionAligned().
+	Pairs []SectionPair `json:"pairs,omitempty"`
+
+	// Text is the text of the section.
+	Text string `json:"text"`
+
+	// Title is the title of the section.
+	Title string `json:"title"`
+}
+
+// SectionPair is a key/value pair from an aligned section.
+type SectionPair struct {
+	// Key is the text before the separator.
+	Key string `json:"key"`
+
+	// Value is the text after the separator.
+	Value string `json:"value"`
+}
+
+// ExitStatusText will return the description of the program exit
+// status, as set by ExitStatus().
+func ExitStatusText() string {
+	return exitStatus
+}
+
+// Flags will return an iterator over all defined flags, including
+// hidden flags, sorted by name.
+func Flags() iter.Seq[FlagInfo] {
+	return func(yield func(FlagInfo) bool) {
+		if !sort.SliceIsSorted(flags, less) {
+			sort.SliceStable(flags, less)
+		}
+
+		for _, f := range flags {
+			if !yield(f.info()) {
+				return
+			}
+		}
+	}
+}
+
+// InfoText will return the description of how the program works, as
+// set by Info().
+func InfoText() string {
+	return info
+}
+
+// Lookup will return the FlagInfo for the named flag. The name can be
+// any of the flag's short or long names, with or without leading
+// dashes.
+func Lookup(name string) (FlagInfo, bool) {
+	var f *cliFlag
+
+	if f = lookup(name); f == nil {
+		return FlagInfo{}, false
+	}
+
+	return f.info(), true
+}
+
+// Sections will return an iterator over all custom sections, in the
+// order they were added.
+func Sections() iter.Seq[SectionInfo] {
+	return func(yield func(SectionInfo) bool) {
+		for _, s := range sections {
+			if !yield(s.info()) {
+				return
+			}
+		}
+	}
+}
+
+func (f *cliFlag) info() FlagInfo {
+	var fi FlagInfo = FlagInfo{
+		Choices:            slices.Clone(f.choices),
+		Default:            f.defVal,
+		Deprecated:         f.deprecated,
+		DeprecationMessage: f.depMsg,
+		Description:        f.desc,
+		Env:                f.env,
+		Hidden:             f.hidden,
+		Longs:              f.longs(),
+		MaxOccurrences:     f.maxOccurs,
+		MinOccurrences:     f.minOccurs,
+		Name:               f.name(),
+		Placeholder:        f.placeholder,
+		ReplacedBy:         f.replacement,
+		Required:           f.required,
+		Shorts:             f.shorts(),
+		Source:             f.source,
+		Type:               f.thetype,
+	}
+
+	if _, ok := f.ptr.(*Counter); ok {
+		fi.IsCounter = true
+	} else {
+		fi.IsList = f.isList
+	}
+
+	if fl := flag.Lookup(f.names()[0]); fl != nil {
+		fi.Value = fl.Value.String()
+	}
+
+	return fi
+}
+
+func (s section) info() SectionInfo {
+	var key string
+	var si SectionInfo = SectionInfo{
+		AlignOn: s.alignOn,
+		Text:    s.text,
+		Title:   s.title,
+	}
+	var val string
+
+	if s.alignOn == "" {
+		return si
+	}
+
+	for _, line := range strings.Split(s.text, "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+
+		key, val, _ = strings.Cut(line, s.alignOn)
+		si.Pairs = append(
+			si.Pairs,
+			SectionPair{
+				Key:   strings.TrimSpace(key),
+				Value: strings.TrimSpace(val),
+			},
+		)
+	}
+
+	return si
+}
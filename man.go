@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var manRef *regexp.Regexp = regexp.MustCompile(
+	`^([^()\s]+)\(([0-9][0-9A-Za-z]*)\)$`,
+)
+
+// Man will write a manpage, in man(7) roff format, to the provided
+// io.Writer. It is based on the same details as Usage() and Readme().
+// SeeAlso entries such as ls(1) are rendered as cross references.
+func Man(w io.Writer) error {
+	var e error
+	var name string = filepath.Base(os.Args[0])
+	var sb strings.Builder
+
+	// Title
+	fmt.Fprintf(&sb, ".TH %s 1\n", roff(strings.ToUpper(name)))
+
+	// Name
+	sb.WriteString(".SH NAME\n")
+	sb.WriteString(roff(name))
+
+	if (Title != "") && (Title != name) {
+		sb.WriteString(" \\- " + roff(Title))
+	}
+
+	sb.WriteString("\n")
+
+	// Synopsis
+	sb.WriteString(".SH SYNOPSIS\n")
+	sb.WriteString(roffText(Banner))
+
+	// Description
+	if info != "" {
+		sb.WriteString(".SH DESCRIPTION\n")
+		sb.WriteString(roffText(info))
+	}
+
+	// Options and descriptions
+	sb.WriteString(".SH OPTIONS\n")
+
+	if !sort.SliceIsSorted(flags, less) {
+		sort.SliceStable(flags, less)
+	}
+
+	for _, f := range flags {
+		if f.shown(false) {
+			sb.WriteString(f.man())
+		}
+	}
+
+	sb.WriteString(getDeprecatedMan())
+
+	for _, s := range sections {
+		sb.WriteString(s.man())
+	}
+
+	if len(Authors) > 0 {
+		sb.WriteString(".SH AUTHORS\n")
+
+		for i, author := range Authors {
+			if i > 0 {
+				sb.WriteString(".br\n")
+			}
+
+			sb.WriteString(roff(author) + "\n")
+		}
+	}
+
+	if BugEmail != "" {
+		sb.WriteString(".SH REPORTING BUGS\n")
+		sb.WriteString(
+			roffText("Email bug reports to <" + BugEmail + ">."),
+		)
+	}
+
+	if exitStatus != "" {
+		sb.WriteString(".SH EXIT STATUS\n")
+		sb.WriteString(roffText(exitStatus))
+	}
+
+	sb.WriteString(getSeeAlsoMan())
+
+	if _, e = io.WriteString(w, sb.String()); e != nil {
+		return e
+	}
+
+	return nil
+}
+
+func getDeprecatedMan() string {
+	var sb strings.Builder
+
+	if !ShowDeprecated {
+		return ""
+	}
+
+	for _, f := range flags {
+		if f.shown(true) {
+			sb.WriteString(f.man())
+		}
+	}
+
+	if sb.Len() == 0 {
+		return ""
+	}
+
+	return ".SH DEPRECATED\n" + sb.String()
+}
+
+func getSeeAlsoMan() string {
+	var m []string
+	var refs []string
+
+	if len(SeeAlso) == 0 {
+		return ""
+	}
+
+	for _, ref := range SeeAlso {
+		ref = strings.TrimSpace(ref)
+
+		if m = manRef.FindStringSubmatch(ref); m != nil {
+			refs = append(refs, "\\fB"+roff(m[1])+"\\fR("+m[2]+")")
+		} else {
+			refs = append(refs, roff(ref))
+		}
+	}
+
+	return ".SH SEE ALSO\n" + strings.Join(refs, ",\n") + "\n"
+}
+
+// roff will escape the provided text so that it is not interpreted
+// as a roff request or escape sequence.
+func roff(text string) string {
+	text = strings.ReplaceAll(text, "\\", "\\e")
+	text = strings.ReplaceAll(text, "-", "\\-")
+
+	if strings.HasPrefix(text, ".") || strings.HasPrefix(text, "'") {
+		text = "\\&" + text
+	}
+
+	return text
+}
+
+// roffText will escape the provided text line by line. Empty lines
+// start a new paragraph and indented lines start a new line, so that
+// lists are preserved.
+func roffText(text string) string {
+	var lines []string = strings.Split(strings.TrimSpace(text), "\n")
+	var para bool = true
+	var sb strings.Builder
+	var trimmed string
+
+	for _, line := range lines {
+		if trimmed = strings.TrimSpace(line); trimmed == "" {
+			if !para {
+				sb.WriteString(".PP\n")
+			}
+
+			para = true
+
+			continue
+		}
+
+		if !para && (trimmed != strings.TrimRight(line, " \t")) {
+			sb.WriteString(".br\n")
+		}
+
+		para = false
+
+		sb.WriteString(roff(trimmed) + "\n")
+	}
+
+	return sb.String()
+}
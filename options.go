@@ -0,0 +1,146 @@
+package cli
+
+// FlagOption allows for configuring optional behavior of a flag. It
+// can be passed to Flag() along with the other arguments.
+type FlagOption func(f *cliFlag)
+
+// Aliases will add additional names for the flag. Single character
+// names are short flags, while longer names are long flags.
+func Aliases(names ...string) FlagOption {
+	return func(f *cliFlag) {
+		f.aliases = append(f.aliases, names...)
+	}
+}
+
+// Choices will limit the flag to the provided values.
+func Choices(vals ...string) FlagOption {
+	return func(f *cliFlag) {
+		f.choices = append(f.choices, vals...)
+	}
+}
+
+// Complete will use the provided Completer to complete the flag's
+// value during dynamic completion. See CompletionShim().
+func Complete(fn Completer) FlagOption {
+	return func(f *cliFlag) {
+		f.completer = fn
+	}
+}
+
+// Decrement will cause a Counter flag to decrement, rather than
+// increment, the Counter. This allows for pairing flags such as -v
+// and -q with the same Counter.
+func Decrement() FlagOption {
+	return func(f *cliFlag) {
+		f.decrement = true
+	}
+}
+
+// Deprecated will mark the flag as deprecated. It will continue to
+// work, but is hidden from Usage() and --readme unless ShowDeprecated
+// is true. A warning with the provided message is printed when the
+// flag is used.
+func Deprecated(msg string) FlagOption {
+	return func(f *cliFlag) {
+		f.deprecated = true
+		f.depMsg = msg
+	}
+}
+
+// Env will use the value of the named environment variable, if the
+// flag was not provided on the command line.
+func Env(name string) FlagOption {
+	return func(f *cliFlag) {
+		f.env = name
+	}
+}
+
+// Required will cause Parse() to fail if the flag was not provided.
+func Required() FlagOption {
+	return func(f *cliFlag) {
+		f.required = true
+	}
+}
+
+// MaxCount will limit a Counter flag to the provided maximum.
+// Repeated flags stop incrementing at the maximum, while explicit
+// values above it are rejected.
+func MaxCount(n uint) FlagOption {
+	return func(f *cliFlag) {
+		f.maxCount = Counter(n)
+	}
+}
+
+// NoDefault will prevent the default value of the flag from being
+// shown in Usage() and --readme.
+func NoDefault() FlagOption {
+	return func(f *cliFlag) {
+		f.noDefault = true
+	}
+}
+
+// Occurrences will limit how many times a list or Counter flag can
+// be provided, at least lo and at most hi times. A hi of 0 means
+// there is no upper limit. The limits are checked by Parse().
+func Occurrences(lo uint, hi uint) FlagOption {
+	return func(f *cliFlag) {
+		f.minOccurs = lo
+		f.maxOccurs = hi
+	}
+}
+
+// OptionalValue will allow the flag to be provided without a value,
+// as in: --color rather than --color=always. The provided value is
+// used when the flag is provided without one. Note that the next arg
+// is never consumed as the value, so it must be provided as
+// --flag=value or -f=value. If the flag has choices, the provided
+// value must be one of them.
+func OptionalValue(val string) FlagOption {
+	return func(f *cliFlag) {
+		f.optional = true
+		f.optVal = val
+	}
+}
+
+// Placeholder will set the name used for the flag's value in Usage()
+// and --readme, as in: --output=FILE
+func Placeholder(name string) FlagOption {
+	return func(f *cliFlag) {
+		f.placeholder = name
+	}
+}
+
+// ReplacedBy will mark the flag as deprecated in favor of the named
+// flag.
+func ReplacedBy(name string) FlagOption {
+	return func(f *cliFlag) {
+		f.deprecated = true
+		f.replacement = name
+	}
+}
+
+// Secret will hide the flag from Usage() and --readme, and will also
+// prevent it from being suggested when an unknown flag is provided.
+func Secret() FlagOption {
+	return func(f *cliFlag) {
+		f.hidden = true
+		f.secret = true
+	}
+}
+
+// NoRepeat will cause Parse() to fail if the flag is provided more
+// than once, under any of its names, as if Strict were true for this
+// flag. It is not valid for list or Counter flags.
+func NoRepeat() FlagOption {
+	return func(f *cliFlag) {
+		f.noRepeat = true
+	}
+}
+
+// Sorted will keep a set flag, such as a StringSet, sorted in
+// increasing order.
+func Sorted() FlagOption {
+	return func(f *cliFlag) {
+		f.sorted = true
+	}
+}
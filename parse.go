@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"flag"
+	"strings"
+)
+
+type boolFlag interface {
+	IsBoolFlag() bool
+}
+
+func isBoolFlag(fl *flag.Flag) bool {
+	var b boolFlag
+	var ok bool
+
+	if b, ok = fl.Value.(boolFlag); ok {
+		return b.IsBoolFlag()
+	}
+
+	return false
+}
+
+// parse will process the provided args in the same manner as
+// flag.Parse(). It returns the remaining positional args. If lenient
+// is true, as when completing, malformed or unknown flags and invalid
+// values are skipped rather than returned as errors, and a
+// terminating -- is kept in the returned args. A MissingValueError is
+// still returned, as it can only occur for the last arg.
+func parse(args []string, lenient bool) ([]string, error) {
+	var dashes string
+	var e error
+	var f *cliFlag
+	var fl *flag.Flag
+	var hasVal bool
+	var name string
+	var raw string
+	var val string
+
+	for len(args) > 0 {
+		raw = args[0]
+
+		if (len(raw) < 2) || (raw[0] != '-') {
+			break
+		}
+
+		args = args[1:]
+
+		if raw == "--" {
+			if lenient {
+				return append([]string{raw}, args...), nil
+			}
+
+			break
+		}
+
+		dashes = "-"
+		if strings.HasPrefix(raw, "--") {
+			dashes = "--"
+		}
+
+		name = raw[len(dashes):]
+
+		if (name == "") || (name[0] == '-') || (name[0] == '=') {
+			if lenient {
+				continue
+			}
+
+			return nil, &SyntaxError{Arg: raw}
+		}
+
+		name, val, hasVal = strings.Cut(name, "=")
+
+		if fl = flag.Lookup(name); fl == nil {
+			if lenient {
+				continue
+			}
+
+			return nil, &UnknownFlagError{
+				Name:        dashes + name,
+				Suggestions: suggest(name),
+			}
+		}
+
+		f = lookup(name)
+
+		switch {
+		case hasVal:
+		case isBoolFlag(fl):
+			val = "true"
+		case (f != nil) && f.optional:
+			// Never consume the next arg for optional values
+			val = f.optVal
+		case len(args) == 0:
+			return nil, &MissingValueError{Name: dashes + name}
+		default:
+			val = args[0]
+			args = args[1:]
+		}
+
+		if !lenient && (f != nil) && f.repeated() {
+			return nil, &RepeatedFlagError{Name: dashes + name}
+		}
+
+		e = set(name, val, FromCommandLine)
+		if (e != nil) && !lenient {
+			return nil, &InvalidValueError{
+				Err:   e,
+				Name:  dashes + name,
+				Value: val,
+			}
+		}
+	}
+
+	return args, nil
+}
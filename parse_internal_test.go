@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	var b bool
+	var n int
+	var s string
+	var tests = []struct {
+		name    string
+		args    []string
+		wantErr bool
+		rest    []string
+		b       bool
+		n       int
+		s       string
+	}{
+		{name: "no args"},
+		{
+			name: "positional args",
+			args: []string{"a", "b"},
+			rest: []string{"a", "b"},
+		},
+		{name: "short bool", args: []string{"-b"}, b: true},
+		{
+			name: "long bool with value",
+			args: []string{"--bool=false"},
+		},
+		{
+			name: "separate values",
+			args: []string{"-n", "5", "--str", "x", "a"},
+			rest: []string{"a"},
+			n:    5,
+			s:    "x",
+		},
+		{
+			name: "equals values",
+			args: []string{"-n=5", "--str=x=y"},
+			n:    5,
+			s:    "x=y",
+		},
+		{
+			name: "value that looks like a flag",
+			args: []string{"-s", "-b"},
+			s:    "-b",
+		},
+		{
+			name: "double dash",
+			args: []string{"-b", "--", "-n", "5"},
+			rest: []string{"-n", "5"},
+			b:    true,
+		},
+		{
+			name: "single dash is positional",
+			args: []string{"-", "-b"},
+			rest: []string{"-", "-b"},
+		},
+		{
+			name: "flags after positional args",
+			args: []string{"a", "-b"},
+			rest: []string{"a", "-b"},
+		},
+		{name: "missing value", args: []string{"-s"}, wantErr: true},
+		{name: "unknown flag", args: []string{"--x"}, wantErr: true},
+		{name: "triple dash", args: []string{"---b"}, wantErr: true},
+		{name: "missing name", args: []string{"-=x"}, wantErr: true},
+		{
+			name:    "invalid value",
+			args:    []string{"-n", "abc"},
+			wantErr: true,
+		},
+	}
+
+	isolateFlags(t)
+
+	Flag(&b, "b", "bool", false, "A bool flag.")
+	Flag(&n, "n", "num", 0, "An int flag.")
+	Flag(&s, "s", "str", "", "A string flag.")
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var e error
+			var rest []string
+
+			b = false
+			n = 0
+			s = ""
+
+			rest, e = parse(test.args, false)
+
+			switch {
+			case test.wantErr && (e == nil):
+				t.Fatal("got no error, want one")
+			case test.wantErr:
+				return
+			case e != nil:
+				t.Fatalf("got error %v, want none", e)
+			}
+
+			if len(rest) == 0 {
+				rest = nil
+			}
+
+			if !reflect.DeepEqual(rest, test.rest) {
+				t.Errorf("got args %q, want %q", rest, test.rest)
+			}
+
+			if (b != test.b) || (n != test.n) || (s != test.s) {
+				t.Errorf(
+					"got b=%t n=%d s=%q, want b=%t n=%d s=%q",
+					b, n, s,
+					test.b, test.n, test.s,
+				)
+			}
+		})
+	}
+}
+
+// isolateFlags will replace the registered flags with an empty set
+// for the duration of the test.
+func isolateFlags(t *testing.T) {
+	t.Helper()
+
+	var cl *flag.FlagSet = flag.CommandLine
+	var cw columnWidth = colWidth
+	var fs []*cliFlag = flags
+
+	t.Cleanup(
+		func() {
+			colWidth = cw
+			flag.CommandLine = cl
+			flags = fs
+		},
+	)
+
+	colWidth = columnWidth{desc: cw.desc}
+	flag.CommandLine = flag.NewFlagSet("test", flag.ContinueOnError)
+	flags = nil
+}
@@ -0,0 +1,271 @@
+package cli_test
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/mjwhitta/cli"
+)
+
+type parseResult struct {
+	args  []string
+	b     bool
+	color string
+	n     int
+	s     string
+}
+
+var (
+	tBool  bool
+	tColor string
+	tInt   int
+	tPort  int
+	tStr   string
+)
+
+func TestMain(m *testing.M) {
+	cli.Flag(&tBool, "b", "bool", false, "A bool flag.")
+	cli.Flag(
+		&tColor,
+		"color",
+		"auto",
+		"An optional value flag.",
+		cli.OptionalValue("always"),
+	)
+	cli.Flag(&tInt, "n", "num", 0, "An int flag.")
+	cli.Flag(
+		&tPort,
+		"port",
+		0,
+		"An int flag with an env var.",
+		cli.Env("CLI_TEST_PORT"),
+	)
+	cli.Flag(&tStr, "s", "str", "", "A string flag.")
+
+	os.Exit(m.Run())
+}
+
+func TestParseArgs(t *testing.T) {
+	var tests = []struct {
+		name string
+		args []string
+		err  error
+		want parseResult
+	}{
+		{
+			name: "no args",
+			want: parseResult{color: "auto"},
+		},
+		{
+			name: "positional args",
+			args: []string{"a", "b"},
+			want: parseResult{
+				args:  []string{"a", "b"},
+				color: "auto",
+			},
+		},
+		{
+			name: "short bool",
+			args: []string{"-b"},
+			want: parseResult{b: true, color: "auto"},
+		},
+		{
+			name: "long bool with value",
+			args: []string{"--bool=false"},
+			want: parseResult{color: "auto"},
+		},
+		{
+			name: "separate values",
+			args: []string{"-n", "5", "--str", "x", "a"},
+			want: parseResult{
+				args:  []string{"a"},
+				color: "auto",
+				n:     5,
+				s:     "x",
+			},
+		},
+		{
+			name: "equals values",
+			args: []string{"-n=5", "--str=x=y"},
+			want: parseResult{color: "auto", n: 5, s: "x=y"},
+		},
+		{
+			name: "empty equals value",
+			args: []string{"--str="},
+			want: parseResult{color: "auto"},
+		},
+		{
+			name: "value that looks like a flag",
+			args: []string{"-s", "-b"},
+			want: parseResult{color: "auto", s: "-b"},
+		},
+		{
+			name: "double dash",
+			args: []string{"-b", "--", "-n", "5"},
+			want: parseResult{
+				args:  []string{"-n", "5"},
+				b:     true,
+				color: "auto",
+			},
+		},
+		{
+			name: "single dash is positional",
+			args: []string{"-", "-b"},
+			want: parseResult{
+				args:  []string{"-", "-b"},
+				color: "auto",
+			},
+		},
+		{
+			name: "flags after positional args",
+			args: []string{"a", "-b"},
+			want: parseResult{
+				args:  []string{"a", "-b"},
+				color: "auto",
+			},
+		},
+		{
+			name: "optional value omitted",
+			args: []string{"--color"},
+			want: parseResult{color: "always"},
+		},
+		{
+			name: "optional value never consumes next arg",
+			args: []string{"--color", "never"},
+			want: parseResult{
+				args:  []string{"never"},
+				color: "always",
+			},
+		},
+		{
+			name: "optional value provided",
+			args: []string{"--color=never"},
+			want: parseResult{color: "never"},
+		},
+		{
+			name: "missing value",
+			args: []string{"-s"},
+			err:  &cli.MissingValueError{Name: "-s"},
+		},
+		{
+			name: "missing long value",
+			args: []string{"-b", "--num"},
+			err:  &cli.MissingValueError{Name: "--num"},
+		},
+		{
+			name: "unknown flag with suggestion",
+			args: []string{"--strr"},
+			err: &cli.UnknownFlagError{
+				Name:        "--strr",
+				Suggestions: []string{"--str"},
+			},
+		},
+		{
+			name: "unknown flag prefix suggestion",
+			args: []string{"--colo"},
+			err: &cli.UnknownFlagError{
+				Name:        "--colo",
+				Suggestions: []string{"--color"},
+			},
+		},
+		{
+			name: "unknown flag uppercase prefix suggestion",
+			args: []string{"--COLO"},
+			err: &cli.UnknownFlagError{
+				Name:        "--COLO",
+				Suggestions: []string{"--color"},
+			},
+		},
+		{
+			name: "unknown flag too far for suggestion",
+			args: []string{"--bogus"},
+			err:  &cli.UnknownFlagError{Name: "--bogus"},
+		},
+		{
+			name: "unknown flag without suggestion",
+			args: []string{"--zzzzzz"},
+			err:  &cli.UnknownFlagError{Name: "--zzzzzz"},
+		},
+		{
+			name: "triple dash",
+			args: []string{"---b"},
+			err:  &cli.SyntaxError{Arg: "---b"},
+		},
+		{
+			name: "missing name",
+			args: []string{"-=x"},
+			err:  &cli.SyntaxError{Arg: "-=x"},
+		},
+		{
+			name: "invalid value",
+			args: []string{"-n", "abc"},
+			err:  &cli.InvalidValueError{Name: "-n", Value: "abc"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var e error
+			var got parseResult
+
+			tBool = false
+			tColor = "auto"
+			tInt = 0
+			tStr = ""
+
+			e = cli.ParseArgs(test.args)
+			checkErr(t, e, test.err)
+
+			if test.err != nil {
+				return
+			}
+
+			got = parseResult{
+				args:  cli.Args(),
+				b:     tBool,
+				color: tColor,
+				n:     tInt,
+				s:     tStr,
+			}
+
+			if len(got.args) == 0 {
+				got.args = nil
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+// checkErr will compare errors by type and, for errors without an
+// underlying cause, by value.
+func checkErr(t *testing.T, got error, want error) {
+	t.Helper()
+
+	switch {
+	case (got == nil) && (want == nil):
+		return
+	case (got == nil) || (want == nil):
+		t.Fatalf("got error %v, want %v", got, want)
+	case reflect.TypeOf(got) != reflect.TypeOf(want):
+		t.Fatalf("got error %T, want %T", got, want)
+	}
+
+	if iv, ok := want.(*cli.InvalidValueError); ok {
+		if gv, ok := got.(*cli.InvalidValueError); ok {
+			if (gv.Name != iv.Name) || (gv.Value != iv.Value) ||
+				(gv.Err == nil) {
+				t.Fatalf("got error %+v, want %+v", gv, iv)
+			}
+		}
+
+		return
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got error %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"strings"
+
+	"github.com/mjwhitta/errors"
+)
+
+const (
+	readmeBegin string = "<!-- cli:begin -->"
+	readmeEnd   string = "<!-- cli:end -->"
+)
+
+// CheckReadme will return an error if the README.md at the provided
+// path is not what WriteReadme() would write, so that a stale README
+// can be caught in tests. It is also available via the hidden
+// --readme-check flag, which exits non-zero if the file is stale.
+func CheckReadme(path string) error {
+	var b []byte
+	var e error
+	var out string
+
+	if b, e = os.ReadFile(path); e != nil {
+		return errors.Newf("failed to read %s: %w", path, e)
+	}
+
+	if out, e = updateReadme(path, string(b)); e != nil {
+		return e
+	}
+
+	if out != string(b) {
+		return errors.Newf("%s is out of date", path)
+	}
+
+	return nil
+}
+
+// WriteReadme will write the README.md to the provided path. If the
+// file already exists and contains the <!-- cli:begin --> and
+// <!-- cli:end --> markers, only the content between the markers is
+// replaced, leaving any hand-written content untouched. Otherwise the
+// whole file is written. It is also available via --readme=PATH.
+func WriteReadme(path string) error {
+	var b []byte
+	var e error
+	var fi os.FileInfo
+	var mode os.FileMode = 0o644 //nolint:mnd // u=rw,go=r
+	var out string
+
+	if fi, e = os.Stat(path); e == nil {
+		mode = fi.Mode().Perm()
+
+		if b, e = os.ReadFile(path); e != nil {
+			return errors.Newf("failed to read %s: %w", path, e)
+		}
+	}
+
+	if out, e = updateReadme(path, string(b)); e != nil {
+		return e
+	}
+
+	if bytes.Equal(b, []byte(out)) {
+		return nil
+	}
+
+	if e = os.WriteFile(path, []byte(out), mode); e != nil {
+		return errors.Newf("failed to write %s: %w", path, e)
+	}
+
+	return nil
+}
+
+func readmeText() (string, error) {
+	return render(
+		"readme",
+		"readme",
+		DefaultReadmeTemplate,
+		ReadmeTemplate,
+		true,
+	)
+}
+
+// updateReadme will return the provided README.md content with the
+// generated README.md between the markers, or the generated
+// README.md if there are no markers.
+func updateReadme(path string, old string) (string, error) {
+	var after string
+	var before string
+	var e error
+	var found bool
+	var out string
+
+	if out, e = readmeText(); e != nil {
+		return "", e
+	}
+
+	if !strings.Contains(old, readmeBegin) &&
+		!strings.Contains(old, readmeEnd) {
+		return out, nil
+	}
+
+	if before, after, found = strings.Cut(old, readmeBegin); !found {
+		return "", errors.Newf("%s is missing %s", path, readmeBegin)
+	}
+
+	if _, after, found = strings.Cut(after, readmeEnd); !found {
+		return "", errors.Newf("%s is missing %s", path, readmeEnd)
+	}
+
+	if !strings.HasSuffix(out, "\n") {
+		out += "\n"
+	}
+
+	return before + readmeBegin + "\n" + out + readmeEnd + after, nil
+}
@@ -9,6 +9,35 @@ type section struct {
 	title   string
 }
 
+// man will return the section in man(7) roff format. Aligned
+// sections are rendered as .TP entries.
+func (s section) man() string {
+	var key string
+	var sb strings.Builder
+	var val string
+
+	sb.WriteString(".SH " + roff(strings.ToUpper(s.title)) + "\n")
+
+	if s.alignOn == "" {
+		sb.WriteString(roffText(s.text))
+		return sb.String()
+	}
+
+	for _, line := range strings.Split(s.text, "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+
+		key, val, _ = strings.Cut(line, s.alignOn)
+
+		sb.WriteString(".TP\n")
+		sb.WriteString(roff(strings.TrimSpace(key)) + "\n")
+		sb.WriteString(roff(strings.TrimSpace(val)) + "\n")
+	}
+
+	return sb.String()
+}
+
 // String will return a string representation of the section.
 func (s section) String() string {
 	var key string
@@ -24,7 +53,7 @@ func (s section) String() string {
 			sb.WriteString(line + "\n")
 		}
 	} else {
-		sb.WriteString(s.title + "\n")
+		sb.WriteString(ansi(colorize(), ansiBold, s.title) + "\n")
 
 		if s.alignOn == "" {
 			for _, line := range wrap(s.text, MaxWidth-TabWidth) {
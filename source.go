@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"flag"
+	"sort"
+)
+
+// FlagSource describes where the value of a flag came from.
+type FlagSource int
+
+// Possible sources for the value of a flag, from lowest to highest
+// precedence.
+const (
+	FromDefault FlagSource = iota
+	FromConfig
+	FromEnv
+	FromCommandLine
+)
+
+// String will return a string representation of the FlagSource.
+func (s FlagSource) String() string {
+	switch s {
+	case FromCommandLine:
+		return "command line"
+	case FromConfig:
+		return "config"
+	case FromDefault:
+		return "default"
+	case FromEnv:
+		return "environment"
+	}
+
+	return "unknown"
+}
+
+// IsSet will return whether or not the named flag was provided on the
+// command line, via the environment, or via SetConfig(). The name can
+// be any of the flag's short or long names, with or without leading
+// dashes.
+func IsSet(name string) bool {
+	return Source(name) != FromDefault
+}
+
+// SetConfig will set the named flag to the provided value, as read
+// from a config file. The flag is left untouched if it was already
+// provided on the command line or via the environment.
+func SetConfig(name string, val string) error {
+	var e error
+	var f *cliFlag
+
+	if f = lookup(name); f == nil {
+		return &UnknownFlagError{Name: dashed(name)}
+	}
+
+	if f.source > FromConfig {
+		return nil
+	}
+
+	if e = f.set(f.names()[0], val, FromConfig); e != nil {
+		return &InvalidValueError{Err: e, Name: f.name(), Value: val}
+	}
+
+	return nil
+}
+
+// Source will return where the value of the named flag came from.
+// The name can be any of the flag's short or long names, with or
+// without leading dashes.
+func Source(name string) FlagSource {
+	var f *cliFlag
+
+	if f = lookup(name); f == nil {
+		return FromDefault
+	}
+
+	return f.source
+}
+
+// Visit will call the provided function for each flag that was set,
+// in sorted order, with the flag's name and the source of its value.
+func Visit(fn func(name string, src FlagSource)) {
+	if !sort.SliceIsSorted(flags, less) {
+		sort.SliceStable(flags, less)
+	}
+
+	for _, f := range flags {
+		if f.source != FromDefault {
+			fn(f.name(), f.source)
+		}
+	}
+}
+
+func set(name string, val string, src FlagSource) error {
+	var f *cliFlag
+
+	if f = lookup(name); f == nil {
+		// Not defined via cli, but may still be a valid flag
+		return flag.Set(name, val)
+	}
+
+	return f.set(name, val, src)
+}
@@ -0,0 +1,72 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/mjwhitta/cli"
+)
+
+func TestSourcePrecedence(t *testing.T) {
+	var e error
+
+	t.Setenv("CLI_TEST_PORT", "9")
+
+	// Config is applied before parsing, but env still wins
+	if e = cli.SetConfig("port", "1111"); e != nil {
+		t.Fatal(e)
+	}
+
+	if e = cli.ParseArgs(nil); e != nil {
+		t.Fatal(e)
+	}
+
+	if (tPort != 9) || (cli.Source("port") != cli.FromEnv) {
+		t.Fatalf(
+			"got port=%d (%s), want port=9 (environment)",
+			tPort,
+			cli.Source("port"),
+		)
+	}
+
+	// Config never overrides env
+	if e = cli.SetConfig("port", "2222"); e != nil {
+		t.Fatal(e)
+	}
+
+	if tPort != 9 {
+		t.Fatalf("got port=%d, want port=9", tPort)
+	}
+
+	// Command line always wins
+	if e = cli.ParseArgs([]string{"--port", "5"}); e != nil {
+		t.Fatal(e)
+	}
+
+	if (tPort != 5) || (cli.Source("port") != cli.FromCommandLine) {
+		t.Fatalf(
+			"got port=%d (%s), want port=5 (command line)",
+			tPort,
+			cli.Source("port"),
+		)
+	}
+}
+
+func TestCounterSource(t *testing.T) {
+	var c cli.Counter
+	var e error
+
+	cli.Flag(&c, "v", "verbose", "A Counter flag.")
+
+	if e = cli.SetConfig("verbose", "2"); e != nil {
+		t.Fatal(e)
+	}
+
+	// Command line replaces config, rather than adding to it
+	if e = cli.ParseArgs([]string{"-v"}); e != nil {
+		t.Fatal(e)
+	}
+
+	if c != 1 {
+		t.Fatalf("got verbose=%d, want verbose=1", c)
+	}
+}
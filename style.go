@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"os"
+	"regexp"
+)
+
+const (
+	ansiBold  string = "\x1b[1m"
+	ansiCyan  string = "\x1b[36m"
+	ansiDim   string = "\x1b[2m"
+	ansiReset string = "\x1b[0m"
+)
+
+var ansiSeq *regexp.Regexp = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// ansi will wrap the provided text in the provided ANSI escape code,
+// if on is true.
+func ansi(on bool, code string, text string) string {
+	if !on || (text == "") {
+		return text
+	}
+
+	return code + text + ansiReset
+}
+
+// colorize will return whether or not Usage() should be styled. It
+// is disabled if NO_COLOR is set and forced if CLICOLOR_FORCE is set
+// to anything other than 0. Otherwise it is only enabled if stderr is
+// a terminal.
+func colorize() bool {
+	var e error
+	var fi os.FileInfo
+
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	switch os.Getenv("CLICOLOR_FORCE") {
+	case "", "0":
+	default:
+		return true
+	}
+
+	if fi, e = os.Stderr.Stat(); e != nil {
+		return false
+	}
+
+	return (fi.Mode() & os.ModeCharDevice) != 0
+}
+
+// visibleLen will return the length of the provided text, ignoring
+// any ANSI escape codes.
+func visibleLen(text string) int {
+	return len(ansiSeq.ReplaceAllString(text, ""))
+}
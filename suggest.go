@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"slices"
+	"strings"
+)
+
+// distance will return the Damerau-Levenshtein (optimal string
+// alignment) distance between two strings.
+func distance(a string, b string) int {
+	var cost int
+	var d [][]int = make([][]int, len(a)+1)
+
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost = 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = min(
+				d[i-1][j]+1,
+				d[i][j-1]+1,
+				d[i-1][j-1]+cost,
+			)
+
+			if (i > 1) && (j > 1) &&
+				(a[i-1] == b[j-2]) && (a[i-2] == b[j-1]) {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(a)][len(b)]
+}
+
+// suggest will return the registered flags that most closely match
+// the provided unknown flag name. Secret flags are never suggested.
+func suggest(name string) []string {
+	var best int = 2 //nolint:mnd // Max distance is 2
+	var d int
+	var lower string
+	var out []string
+
+	name = strings.ToLower(name)
+
+	for _, f := range flags {
+		if f.secret {
+			continue
+		}
+
+		for _, n := range f.names() {
+			lower = strings.ToLower(n)
+			d = distance(name, lower)
+
+			switch {
+			case (len(name) > 1) && strings.HasPrefix(lower, name):
+				// Treat prefixes as close enough
+				d = min(d, 2) //nolint:mnd // Max distance is 2
+			case d >= len(name):
+				continue
+			}
+
+			switch {
+			case d < best:
+				best = d
+				out = []string{dashed(n)}
+			case d == best:
+				out = append(out, dashed(n))
+			}
+		}
+	}
+
+	slices.Sort(out)
+
+	return slices.Compact(out)
+}
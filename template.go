@@ -0,0 +1,291 @@
+package cli
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/mjwhitta/errors"
+)
+
+// DefaultHelpTemplate is the text/template used by Usage(), unless
+// HelpTemplate is set. It is made of the header, options, and extra
+// blocks, which are printed by PrintHeader(), PrintDefaults(), and
+// PrintExtra() respectively.
+const DefaultHelpTemplate string = `{{block "header" . -}}
+{{range wrap .MaxWidth (print "Usage: " .Banner)}}{{.}}
+{{end}}
+{{bold "DESCRIPTION"}}
+{{range wrap (sub .MaxWidth .TabWidth) .Info}}{{indent $.TabWidth .}}
+{{end}}
+{{bold "OPTIONS"}}
+{{end -}}
+
+{{block "options" . -}}
+{{range .Flags}}{{usage .}}{{end}}
+{{- if .Align}}
+{{end}}
+{{- if .Deprecated}}{{bold "DEPRECATED"}}
+{{range .Deprecated}}{{usage .}}{{end}}
+{{- if .Align}}
+{{end}}
+{{- end}}
+{{- end -}}
+
+{{block "extra" . -}}
+{{$w := sub .MaxWidth .TabWidth -}}
+{{range .Sections}}{{section .}}{{end}}
+{{- if .Authors}}{{bold "AUTHORS"}}
+{{range .Authors}}{{indent $.TabWidth .}}
+{{end}}
+{{- end}}
+{{- if .BugEmail}}
+{{- $msg := print "Email bug reports to <" .BugEmail ">."}}
+{{bold "BUG REPORTS"}}
+{{range wrap $w $msg}}{{indent $.TabWidth .}}
+{{end}}
+{{- end}}
+{{- if .ExitStatus}}
+{{bold "EXIT STATUS"}}
+{{range wrap $w .ExitStatus}}{{indent $.TabWidth .}}
+{{end}}
+{{- end}}
+{{- if .SeeAlso}}
+{{bold "SEE ALSO"}}
+{{range wrap .MaxWidth (join ", " .SeeAlso)}}{{indent $.TabWidth .}}
+{{end}}
+{{- end}}
+{{- end -}}
+`
+
+// DefaultReadmeTemplate is the text/template used by Readme(),
+// unless ReadmeTemplate is set.
+const DefaultReadmeTemplate string = `# {{.Title}}
+
+## Synopsis
+
+{{range wrap .MaxWidth .Banner}}` + "`{{.}}`" + `
+{{end}}
+## Description
+
+{{range wrap .MaxWidth .Info}}{{.}}
+{{end}}
+## Options
+
+{{tableHeader}}{{range .Flags}}{{table .}}{{end}}
+{{- if .Deprecated}}
+## Deprecated
+
+{{tableHeader}}{{range .Deprecated}}{{table .}}{{end}}
+{{- end}}
+{{- range .Sections}}{{section .}}{{end}}
+{{- if .Authors}}
+## Authors
+
+{{range .Authors}}{{.}}
+{{end}}
+{{- end}}
+{{- if .BugEmail}}
+{{- $msg := print "Email bug reports to <" .BugEmail ">."}}
+## Reporting bugs
+
+{{range wrap .MaxWidth $msg}}{{.}}
+{{end}}
+{{- end}}
+{{- if .ExitStatus}}
+## Exit status
+
+{{range wrap .MaxWidth .ExitStatus}}{{.}}
+{{end}}
+{{- end}}
+{{- if .SeeAlso}}
+## See also
+
+{{range wrap .MaxWidth (join ", " .SeeAlso)}}{{.}}
+{{end}}
+{{- end -}}
+`
+
+// TemplateData is the data available to HelpTemplate and
+// ReadmeTemplate. Along with the fields below, the following funcs
+// are available to templates:
+//
+//	bold TEXT            bold TEXT, if Usage() is styled
+//	column FLAG          the flag names and placeholder
+//	description FLAG     the flag description, with notes
+//	indent N TEXT        prefix each line of TEXT with N spaces
+//	join SEP LIST        join LIST with SEP
+//	section SECTION      the section as in Usage() or Readme()
+//	sub A B              subtract B from A
+//	table FLAG           the flag as a README.md table row
+//	tableHeader          the README.md table header
+//	usage FLAG           the flag as in Usage()
+//	wrap WIDTH TEXT      split TEXT into lines no wider than WIDTH
+type TemplateData struct {
+	// Align is the value of Align.
+	Align bool
+
+	// Authors is the value of Authors.
+	Authors []string
+
+	// Banner is the value of Banner.
+	Banner string
+
+	// BugEmail is the value of BugEmail.
+	BugEmail string
+
+	// Deprecated are the deprecated flags, if ShowDeprecated is
+	// true.
+	Deprecated []FlagInfo
+
+	// ExitStatus is the value set with ExitStatus().
+	ExitStatus string
+
+	// Flags are the flags that are not hidden or deprecated.
+	Flags []FlagInfo
+
+	// Info is the value set with Info().
+	Info string
+
+	// MaxWidth is the value of MaxWidth.
+	MaxWidth int
+
+	// Sections are the custom sections.
+	Sections []SectionInfo
+
+	// SeeAlso is the value of SeeAlso.
+	SeeAlso []string
+
+	// TabWidth is the value of TabWidth.
+	TabWidth int
+
+	// Title is the value of Title.
+	Title string
+}
+
+func indent(n int, text string) string {
+	var lines []string = strings.Split(text, "\n")
+	var pad string = strings.Repeat(" ", n)
+
+	for i := range lines {
+		lines[i] = pad + lines[i]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func newTemplateData() TemplateData {
+	var td TemplateData = TemplateData{
+		Align:      Align,
+		Authors:    Authors,
+		Banner:     Banner,
+		BugEmail:   BugEmail,
+		ExitStatus: exitStatus,
+		Info:       info,
+		MaxWidth:   MaxWidth,
+		Sections:   slices.Collect(Sections()),
+		SeeAlso:    SeeAlso,
+		TabWidth:   TabWidth,
+		Title:      Title,
+	}
+
+	if !sort.SliceIsSorted(flags, less) {
+		sort.SliceStable(flags, less)
+	}
+
+	for _, f := range flags {
+		if f.shown(false) {
+			td.Flags = append(td.Flags, f.info())
+		} else if ShowDeprecated && f.shown(true) {
+			td.Deprecated = append(td.Deprecated, f.info())
+		}
+	}
+
+	return td
+}
+
+// render will execute the named block of the named template, which
+// is parsed from the default template text and then from the custom
+// template text, if any. This allows custom templates to replace the
+// whole template, or only some blocks.
+func render(
+	name string, block string, def string, custom string, md bool,
+) (string, error) {
+	var e error
+	var sb strings.Builder
+	var t *template.Template
+
+	t = template.New(name).Funcs(templateFuncs(md))
+
+	if t, e = t.Parse(def); e != nil {
+		return "", errors.Newf("invalid %s template: %w", name, e)
+	}
+
+	if custom != "" {
+		if t, e = t.Parse(custom); e != nil {
+			return "", errors.Newf("invalid %s template: %w", name, e)
+		}
+	}
+
+	e = t.ExecuteTemplate(&sb, block, newTemplateData())
+	if e != nil {
+		return "", errors.Newf("invalid %s template: %w", name, e)
+	}
+
+	return sb.String(), nil
+}
+
+func templateFuncs(md bool) template.FuncMap {
+	// Helper to look up the cliFlag for a FlagInfo
+	var get = func(fi FlagInfo, fn func(f *cliFlag) string) string {
+		if f := lookup(fi.Name); f != nil {
+			return fn(f)
+		}
+
+		return ""
+	}
+
+	return template.FuncMap{
+		"bold": func(text string) string {
+			return ansi(!md && colorize(), ansiBold, text)
+		},
+		"column": func(fi FlagInfo) string {
+			return get(
+				fi,
+				func(f *cliFlag) string {
+					return f.column(Align, false)
+				},
+			)
+		},
+		"description": func(fi FlagInfo) string {
+			return get(
+				fi,
+				func(f *cliFlag) string { return f.description(md) },
+			)
+		},
+		"indent": indent,
+		"join": func(sep string, elems []string) string {
+			return strings.Join(elems, sep)
+		},
+		"section": func(si SectionInfo) string {
+			return section{
+				alignOn: si.AlignOn,
+				md:      md,
+				text:    si.Text,
+				title:   si.Title,
+			}.String()
+		},
+		"sub": func(a int, b int) int { return a - b },
+		"table": func(fi FlagInfo) string {
+			return get(fi, (*cliFlag).table)
+		},
+		"tableHeader": tableHeader,
+		"usage": func(fi FlagInfo) string {
+			return get(fi, (*cliFlag).String)
+		},
+		"wrap": func(width int, text string) []string {
+			return wrap(text, width)
+		},
+	}
+}
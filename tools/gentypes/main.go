@@ -9,10 +9,14 @@ import (
 )
 
 func generateFuncs(typ string) string {
+	var bits string
 	var capType string = strings.ToUpper(typ[0:1]) + typ[1:]
+	var elem string
 	var sb strings.Builder
 	var typeList string = capType + "List"
 
+	_, bits, elem = kind(typ)
+
 	// String() func
 	fmt.Fprintf(
 		&sb,
@@ -39,39 +43,12 @@ func generateFuncs(typ string) string {
 		typeList,
 	)
 
-	switch typ {
-	case "float":
-		sb.WriteString("\tvar e error\n")
-		fmt.Fprintf(&sb, "\tvar v %s64\n\n", typ)
-		fmt.Fprintf(
-			&sb,
-			"\tif v, e = strconv.Parse%s(val, 64)",
-			capType,
-		)
-		sb.WriteString("; e != nil {\n")
-		sb.WriteString(
-			"\t\treturn errors.Newf(\"failed to parse %s as ",
-		)
-		sb.WriteString(typ + ": %w\", val, e)\n")
-		sb.WriteString("\t}\n\n")
-		sb.WriteString("\t(*list) = append(*list, v)\n")
-	case "int", "uint":
-		sb.WriteString("\tvar e error\n")
-		fmt.Fprintf(&sb, "\tvar v %s64\n\n", typ)
-		fmt.Fprintf(
-			&sb,
-			"\tif v, e = strconv.Parse%s(val, 0, 64); e != nil {",
-			capType,
-		)
-		sb.WriteString(
-			"\n\t\treturn errors.Newf(\"failed to parse %s as ",
-		)
-		sb.WriteString(typ + ": %w\", val, e)\n")
-		sb.WriteString("\t}\n\n")
-		sb.WriteString("\t(*list) = append(*list, v)\n")
-	case "string":
-		sb.WriteString("\t(*list) = append(*list, val)\n")
-	}
+	sb.WriteString(parseVal(typ))
+	fmt.Fprintf(
+		&sb,
+		"\t(*list) = append(*list, %s)\n",
+		conv(elem, bits),
+	)
 
 	sb.WriteString("\n\treturn nil\n")
 	sb.WriteString("}\n")
@@ -79,26 +56,128 @@ func generateFuncs(typ string) string {
 	return sb.String()
 }
 
+func generateSetFuncs(typ string) string {
+	var bits string
+	var capType string = strings.ToUpper(typ[0:1]) + typ[1:]
+	var elem string
+	var sb strings.Builder
+	var typeSet string = capType + "Set"
+
+	_, bits, elem = kind(typ)
+
+	// Contains() func
+	fmt.Fprintf(
+		&sb,
+		"// Contains returns whether or not val is in the %s.\n",
+		typeSet,
+	)
+	fmt.Fprintf(
+		&sb,
+		"func (set *%s) Contains(val %s) bool {\n",
+		typeSet,
+		elem,
+	)
+	sb.WriteString("\treturn slices.Contains(*set, val)\n")
+	sb.WriteString("}\n\n")
+
+	// Set() func
+	fmt.Fprintf(
+		&sb,
+		"// Set adds a %s to a %s, if not already present.\n",
+		typ,
+		typeSet,
+	)
+	fmt.Fprintf(
+		&sb,
+		"func (set *%s) Set(val string) error {\n",
+		typeSet,
+	)
+
+	sb.WriteString(parseVal(typ))
+	fmt.Fprintf(
+		&sb,
+		"\tif !slices.Contains(*set, %s) {\n",
+		conv(elem, bits),
+	)
+	fmt.Fprintf(
+		&sb,
+		"\t\t(*set) = append(*set, %s)\n",
+		conv(elem, bits),
+	)
+	sb.WriteString("\t}\n")
+
+	sb.WriteString("\n\treturn nil\n")
+	sb.WriteString("}\n\n")
+
+	// Sort() func
+	fmt.Fprintf(
+		&sb,
+		"// Sort sorts the %s in increasing order.\n",
+		typeSet,
+	)
+	fmt.Fprintf(&sb, "func (set *%s) Sort() {\n", typeSet)
+	sb.WriteString("\tslices.Sort(*set)\n")
+	sb.WriteString("}\n\n")
+
+	// String() func
+	fmt.Fprintf(
+		&sb,
+		"// String returns a string representation of the %s.\n",
+		typeSet,
+	)
+	fmt.Fprintf(&sb, "func (set *%s) String() string {\n", typeSet)
+	sb.WriteString(
+		"\tvar out []string = make([]string, 0, len(*set))\n\n",
+	)
+	sb.WriteString("\tfor _, v := range *set {\n")
+	sb.WriteString("\t\tout = append(out, fmt.Sprint(v))\n")
+	sb.WriteString("\t}\n\n")
+	sb.WriteString(
+		"\treturn \"{\" + strings.Join(out, \", \") + \"}\"\n",
+	)
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+func generateSetTypes(typ string) string {
+	var base string
+	var capType string = strings.ToUpper(typ[0:1]) + typ[1:]
+	var elem string
+	var sb strings.Builder
+	var typeSet string = capType + "Set"
+
+	base, _, elem = kind(typ)
+
+	// Type declaration
+	fmt.Fprintf(
+		&sb,
+		"\n// %s allows setting unique values multiple times, as",
+		typeSet,
+	)
+	fmt.Fprintf(&sb, " in:\n// --flag=%s1 --flag=%s2\n", base, base)
+	fmt.Fprintf(&sb, "type %s []%s\n", typeSet, elem)
+
+	return sb.String()
+}
+
 func generateTypes(typ string) string {
+	var base string
 	var capType string = strings.ToUpper(typ[0:1]) + typ[1:]
+	var elem string
 	var sb strings.Builder
 	var typeList string = capType + "List"
 
+	base, _, elem = kind(typ)
+
 	// Type declaration
 	fmt.Fprintf(
 		&sb,
 		"\n// %s allows setting a value multiple times, as",
 		typeList,
 	)
-	fmt.Fprintf(&sb, " in:\n// --flag=%s1 --flag=%s2\n", typ, typ)
-	fmt.Fprintf(&sb, "type %s []%s", typeList, typ)
-
-	switch typ {
-	case "float", "int", "uint":
-		sb.WriteString("64")
-	}
-
-	sb.WriteString("\n")
+	fmt.Fprintf(&sb, " in:\n// --flag=%s1 --flag=%s2\n", base, base)
+	fmt.Fprintf(&sb, "type %s []%s\n", typeList, elem)
 
 	return sb.String()
 }
@@ -110,18 +189,100 @@ func header() string {
 	sb.WriteString("package cli\n\n")
 	sb.WriteString("import (\n")
 	sb.WriteString("\t\"fmt\"\n")
-	sb.WriteString("\t\"strconv\"\n\n")
+	sb.WriteString("\t\"slices\"\n")
+	sb.WriteString("\t\"strconv\"\n")
+	sb.WriteString("\t\"strings\"\n\n")
 	sb.WriteString("\t\"github.com/mjwhitta/errors\"\n")
 	sb.WriteString(")\n")
 
 	return sb.String()
 }
 
+// conv will return the expression to convert the parsed value to the
+// list element type.
+func conv(elem string, bits string) string {
+	switch {
+	case elem == "string":
+		return "val"
+	case bits == "64":
+		return "v"
+	}
+
+	return elem + "(v)"
+}
+
+// kind will return the base type, bit size, and element type for the
+// provided type. Types without an explicit size are 64 bits.
+func kind(typ string) (string, string, string) {
+	var base string = strings.TrimRight(typ, "0123456789")
+	var bits string = strings.TrimPrefix(typ, base)
+
+	switch {
+	case base == "string":
+		return base, "", base
+	case bits == "":
+		return base, "64", base + "64"
+	default:
+		return base, bits, typ
+	}
+}
+
+// parseVal will return the code to parse val into v, for the
+// provided type. Strings need no parsing.
+func parseVal(typ string) string {
+	var base string
+	var bits string
+	var sb strings.Builder
+
+	base, bits, _ = kind(typ)
+
+	switch base {
+	case "float":
+		sb.WriteString("\tvar e error\n")
+		fmt.Fprintf(&sb, "\tvar v %s64\n\n", base)
+		fmt.Fprintf(
+			&sb,
+			"\tif v, e = strconv.ParseFloat(val, %s)",
+			bits,
+		)
+		sb.WriteString("; e != nil {\n")
+	case "int", "uint":
+		sb.WriteString("\tvar e error\n")
+		fmt.Fprintf(&sb, "\tvar v %s64\n\n", base)
+		fmt.Fprintf(
+			&sb,
+			"\tif v, e = strconv.Parse%s(val, 0, %s); e != nil {\n",
+			strings.ToUpper(base[0:1])+base[1:],
+			bits,
+		)
+	default:
+		return ""
+	}
+
+	sb.WriteString("\t\treturn errors.Newf(\"failed to parse %s as ")
+	sb.WriteString(typ + ": %w\", val, e)\n")
+	sb.WriteString("\t}\n\n")
+
+	return sb.String()
+}
+
 func main() {
 	var e error
 	var f *os.File
 	var fn string = "generated.go"
-	var types []string = []string{"float", "int", "string", "uint"}
+	var types []string = []string{
+		"float",
+		"float32",
+		"int",
+		"int8",
+		"int16",
+		"int32",
+		"string",
+		"uint",
+		"uint8",
+		"uint16",
+		"uint32",
+	}
 
 	if f, e = os.Create(fn); e != nil {
 		panic(errors.Newf("failed to create %s: %w", fn, e))
@@ -142,6 +303,12 @@ func main() {
 		}
 	}
 
+	for _, thetype := range types {
+		if _, e = f.WriteString(generateSetTypes(thetype)); e != nil {
+			panic(e)
+		}
+	}
+
 	for _, thetype := range types {
 		if _, e = f.WriteString("\n"); e != nil {
 			panic(e)
@@ -151,4 +318,14 @@ func main() {
 			panic(e)
 		}
 	}
+
+	for _, thetype := range types {
+		if _, e = f.WriteString("\n"); e != nil {
+			panic(e)
+		}
+
+		if _, e = f.WriteString(generateSetFuncs(thetype)); e != nil {
+			panic(e)
+		}
+	}
 }
@@ -1,6 +1,34 @@
 package cli
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
+
+func dashed(name string) string {
+	switch {
+	case strings.HasPrefix(name, "-"):
+		return name
+	case len(name) == 1:
+		return "-" + name
+	default:
+		return "--" + name
+	}
+}
+
+func lookup(name string) *cliFlag {
+	name = strings.TrimLeft(name, "-")
+
+	for _, f := range flags {
+		for _, n := range f.names() {
+			if n == name {
+				return f
+			}
+		}
+	}
+
+	return nil
+}
 
 func less(i int, j int) bool {
 	var left string = flags[i].long
@@ -34,3 +62,12 @@ func less(i int, j int) bool {
 
 	return strings.ToLower(left) < strings.ToLower(right)
 }
+
+// times will return n followed by "time" or "times", as appropriate.
+func times(n uint) string {
+	if n == 1 {
+		return "1 time"
+	}
+
+	return fmt.Sprintf("%d times", n)
+}
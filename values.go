@@ -0,0 +1,270 @@
+package cli
+
+import (
+	"flag"
+	"reflect"
+	"strconv"
+
+	"github.com/mjwhitta/errors"
+)
+
+// boolValue is the flag.Value used for bool flags that are not
+// registered with the flag package directly.
+type boolValue struct {
+	ptr *bool
+}
+
+// floatValue is the flag.Value used for float flags that are not
+// registered with the flag package directly.
+type floatValue[T float32 | float64] struct {
+	bits int
+	name string
+	ptr  *T
+}
+
+// intValue is the flag.Value used for int flags that are not
+// registered with the flag package directly.
+type intValue[T int | int8 | int16 | int32 | int64] struct {
+	bits int
+	name string
+	ptr  *T
+}
+
+// nullValue is the flag.Value used for pointer-to-pointer flags,
+// which remain nil until the flag is provided.
+type nullValue struct {
+	ptr reflect.Value
+}
+
+// stringValue is the flag.Value used for string flags that are not
+// registered with the flag package directly.
+type stringValue struct {
+	ptr *string
+}
+
+// uintValue is the flag.Value used for uint flags that are not
+// registered with the flag package directly.
+type uintValue[T uint | uint8 | uint16 | uint32 | uint64] struct {
+	bits int
+	name string
+	ptr  *T
+}
+
+// newValue will return a flag.Value for the provided scalar pointer.
+//
+//nolint:mnd // Bit sizes are not magic numbers
+func newValue(ptr any) flag.Value {
+	switch ptr := ptr.(type) {
+	case *bool:
+		return &boolValue{ptr: ptr}
+	case *float32:
+		return &floatValue[float32]{
+			bits: 32,
+			name: "float32",
+			ptr:  ptr,
+		}
+	case *float64:
+		return &floatValue[float64]{
+			bits: 64,
+			name: "float64",
+			ptr:  ptr,
+		}
+	case *int:
+		return &intValue[int]{
+			bits: strconv.IntSize,
+			name: "int",
+			ptr:  ptr,
+		}
+	case *int8:
+		return &intValue[int8]{bits: 8, name: "int8", ptr: ptr}
+	case *int16:
+		return &intValue[int16]{bits: 16, name: "int16", ptr: ptr}
+	case *int32:
+		return &intValue[int32]{bits: 32, name: "int32", ptr: ptr}
+	case *int64:
+		return &intValue[int64]{bits: 64, name: "int64", ptr: ptr}
+	case *string:
+		return &stringValue{ptr: ptr}
+	case *uint:
+		return &uintValue[uint]{
+			bits: strconv.IntSize,
+			name: "uint",
+			ptr:  ptr,
+		}
+	case *uint8:
+		return &uintValue[uint8]{bits: 8, name: "uint8", ptr: ptr}
+	case *uint16:
+		return &uintValue[uint16]{bits: 16, name: "uint16", ptr: ptr}
+	case *uint32:
+		return &uintValue[uint32]{bits: 32, name: "uint32", ptr: ptr}
+	case *uint64:
+		return &uintValue[uint64]{bits: 64, name: "uint64", ptr: ptr}
+	}
+
+	return nil
+}
+
+func parseError(val string, name string, e error) error {
+	var ne *strconv.NumError
+	var ok bool
+
+	if ne, ok = e.(*strconv.NumError); ok {
+		if ne.Err == strconv.ErrRange {
+			return errors.Newf("%s is out of range for %s", val, name)
+		}
+	}
+
+	return errors.Newf("failed to parse %s as %s: %w", val, name, e)
+}
+
+// IsBoolFlag allows boolValue to be called as --flag rather than
+// --flag=true.
+func (v *boolValue) IsBoolFlag() bool {
+	return true
+}
+
+// Set will parse the provided value as a bool.
+func (v *boolValue) Set(val string) error {
+	var b bool
+	var e error
+
+	if b, e = strconv.ParseBool(val); e != nil {
+		return parseError(val, "bool", e)
+	}
+
+	*v.ptr = b
+
+	return nil
+}
+
+// String will return a string representation of the boolValue.
+func (v *boolValue) String() string {
+	if v.ptr == nil {
+		return "false"
+	}
+
+	return strconv.FormatBool(*v.ptr)
+}
+
+// Set will parse the provided value, ensuring it fits in the
+// underlying type.
+func (v *floatValue[T]) Set(val string) error {
+	var e error
+	var f float64
+
+	if f, e = strconv.ParseFloat(val, v.bits); e != nil {
+		return parseError(val, v.name, e)
+	}
+
+	*v.ptr = T(f)
+
+	return nil
+}
+
+// String will return a string representation of the floatValue.
+func (v *floatValue[T]) String() string {
+	if v.ptr == nil {
+		return "0"
+	}
+
+	return strconv.FormatFloat(float64(*v.ptr), 'g', -1, v.bits)
+}
+
+// Set will parse the provided value, ensuring it fits in the
+// underlying type.
+func (v *intValue[T]) Set(val string) error {
+	var e error
+	var i int64
+
+	if i, e = strconv.ParseInt(val, 0, v.bits); e != nil {
+		return parseError(val, v.name, e)
+	}
+
+	*v.ptr = T(i)
+
+	return nil
+}
+
+// String will return a string representation of the intValue.
+func (v *intValue[T]) String() string {
+	if v.ptr == nil {
+		return "0"
+	}
+
+	return strconv.FormatInt(int64(*v.ptr), 10)
+}
+
+// IsBoolFlag allows a nullValue for a bool to be called as --flag
+// rather than --flag=true.
+func (v *nullValue) IsBoolFlag() bool {
+	var ok bool
+
+	if v.ptr.IsValid() {
+		_, ok = v.ptr.Interface().(**bool)
+	}
+
+	return ok
+}
+
+// Set will allocate a new value, parse the provided value into it,
+// and then store it.
+func (v *nullValue) Set(val string) error {
+	var e error
+	var tmp reflect.Value = reflect.New(v.ptr.Elem().Type().Elem())
+
+	if e = newValue(tmp.Interface()).Set(val); e != nil {
+		return e
+	}
+
+	v.ptr.Elem().Set(tmp)
+
+	return nil
+}
+
+// String will return a string representation of the nullValue.
+func (v *nullValue) String() string {
+	if !v.ptr.IsValid() || v.ptr.Elem().IsNil() {
+		return ""
+	}
+
+	return newValue(v.ptr.Elem().Interface()).String()
+}
+
+// Set will store the provided value.
+func (v *stringValue) Set(val string) error {
+	*v.ptr = val
+	return nil
+}
+
+// String will return a string representation of the stringValue.
+func (v *stringValue) String() string {
+	if v.ptr == nil {
+		return ""
+	}
+
+	return *v.ptr
+}
+
+// Set will parse the provided value, ensuring it fits in the
+// underlying type.
+func (v *uintValue[T]) Set(val string) error {
+	var e error
+	var u uint64
+
+	if u, e = strconv.ParseUint(val, 0, v.bits); e != nil {
+		return parseError(val, v.name, e)
+	}
+
+	*v.ptr = T(u)
+
+	return nil
+}
+
+// String will return a string representation of the uintValue.
+func (v *uintValue[T]) String() string {
+	if v.ptr == nil {
+		return "0"
+	}
+
+	return strconv.FormatUint(uint64(*v.ptr), 10)
+}